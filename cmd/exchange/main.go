@@ -1,6 +1,10 @@
 package main
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
 	"github.com/streamingfast/sparkle/cli"
 	_ "github.com/streamingfast/sparkle/entity"
 	"github.com/streamingfast/sparkle/subgraph"
@@ -9,5 +13,32 @@ import (
 
 func main() {
 	subgraph.MainSubgraphDef = exchange.Definition
+
+	cli.RootCmd.PersistentFlags().String("network", "mainnet", fmt.Sprintf("Built-in network profile to index, one of: %s", strings.Join(exchange.NetworkNames(), ", ")))
+	cli.RootCmd.PersistentFlags().String("network-profile", "", "Path to a JSON network profile file, takes precedence over --network when set")
+	cli.RootCmd.PersistentFlags().StringSlice("intervals", nil, fmt.Sprintf("Additional pair and token data intervals, any of: %s", strings.Join(exchange.BucketNames(), ", ")))
+	cli.RootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		name, err := cmd.Flags().GetString("network")
+		if err != nil {
+			return err
+		}
+
+		profilePath, err := cmd.Flags().GetString("network-profile")
+		if err != nil {
+			return err
+		}
+
+		intervals, err := cmd.Flags().GetStringSlice("intervals")
+		if err != nil {
+			return err
+		}
+
+		if err := exchange.ConfigureIntervals(intervals); err != nil {
+			return err
+		}
+
+		return exchange.ConfigureNetwork(name, profilePath)
+	}
+
 	cli.Execute()
 }
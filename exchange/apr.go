@@ -0,0 +1,162 @@
+package exchange
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/streamingfast/sparkle/entity"
+)
+
+// APRs are the liquidity provider fees earned over a period divided by the average reserveUSD
+// sampled at each swap of the period, annualized. Hour and day APRs are derived from summed
+// fields only, so they are recomputed once parallel shards are merged, see `merge.go`. The 7 days
+// APR of pairs is derived from a window of daily sums kept on the pair, so that merged shards
+// recompute it without loading their day data.
+
+const (
+	secondsPerYear = 365 * 86400
+	aprWindowDays  = 7
+)
+
+// periodAPR annualizes `lpFeesUSD` earned over `periodSeconds` on the average reserveUSD.
+func periodAPR(lpFeesUSD, reserveUSDSum *big.Float, reserveUSDSamples *big.Int, periodSeconds int64) *big.Float {
+	if periodSeconds <= 0 || reserveUSDSamples.Sign() == 0 || reserveUSDSum.Sign() == 0 {
+		return big.NewFloat(0)
+	}
+
+	averageReserveUSD := bf().Quo(reserveUSDSum, bf().SetInt(reserveUSDSamples))
+	periodsPerYear := big.NewFloat(float64(secondsPerYear) / float64(periodSeconds))
+
+	return bf().Mul(bf().Quo(lpFeesUSD, averageReserveUSD), periodsPerYear)
+}
+
+// aprToAPY compounds `apr` once every `periodSeconds`.
+func aprToAPY(apr *big.Float, periodSeconds int64) *big.Float {
+	periodsPerYear := float64(secondsPerYear) / float64(periodSeconds)
+	rate, _ := apr.Float64()
+
+	apy := math.Pow(1+rate/periodsPerYear, periodsPerYear) - 1
+	if math.IsInf(apy, 0) || math.IsNaN(apy) {
+		return bf().Set(apr)
+	}
+
+	return big.NewFloat(apy)
+}
+
+func (p *PairHourData) updateAPR() {
+	p.Apr = F(periodAPR(p.LpFeesUSD.Float(), p.ReserveUSDSum.Float(), p.ReserveUSDSamples.Int(), HourBucket.Seconds))
+	p.Apy = F(aprToAPY(p.Apr.Float(), HourBucket.Seconds))
+}
+
+func (p *PairDayData) updateAPR() {
+	p.Apr = F(periodAPR(p.LpFeesUSD.Float(), p.ReserveUSDSum.Float(), p.ReserveUSDSamples.Int(), DayBucket.Seconds))
+	p.Apy = F(aprToAPY(p.Apr.Float(), DayBucket.Seconds))
+}
+
+// aprWindowSlot points to the fields of a pair summing the swaps of one day of its 7 days APR
+// window.
+type aprWindowSlot struct {
+	LpFeesUSD, ReserveUSDSum *entity.Float
+	ReserveUSDSamples        *entity.Int
+}
+
+func (p *Pair) aprWindowSlots() [aprWindowDays]aprWindowSlot {
+	return [aprWindowDays]aprWindowSlot{
+		{&p.AprLpFeesUSD0, &p.AprReserveUSDSum0, &p.AprReserveUSDSamples0},
+		{&p.AprLpFeesUSD1, &p.AprReserveUSDSum1, &p.AprReserveUSDSamples1},
+		{&p.AprLpFeesUSD2, &p.AprReserveUSDSum2, &p.AprReserveUSDSamples2},
+		{&p.AprLpFeesUSD3, &p.AprReserveUSDSum3, &p.AprReserveUSDSamples3},
+		{&p.AprLpFeesUSD4, &p.AprReserveUSDSum4, &p.AprReserveUSDSamples4},
+		{&p.AprLpFeesUSD5, &p.AprReserveUSDSum5, &p.AprReserveUSDSamples5},
+		{&p.AprLpFeesUSD6, &p.AprReserveUSDSum6, &p.AprReserveUSDSamples6},
+	}
+}
+
+// aprWindowSlot returns the slot of the day `dayID`.
+func (p *Pair) aprWindowSlot(dayID int64) aprWindowSlot {
+	slot := dayID % aprWindowDays
+	if slot < 0 {
+		slot += aprWindowDays
+	}
+
+	return p.aprWindowSlots()[slot]
+}
+
+// advanceAPRWindow moves the 7 days APR window of the pair to end at `timestamp`, clearing the
+// slots of the days entering it. It returns false when the window already ends there or later.
+func (p *Pair) advanceAPRWindow(timestamp int64) bool {
+	if timestamp <= p.AprWindowTimestamp {
+		return false
+	}
+
+	from, to := DayBucket.ID(p.AprWindowTimestamp)+1, DayBucket.ID(timestamp)
+	if to-from >= aprWindowDays {
+		from = to - aprWindowDays + 1
+	}
+	for id := from; id <= to; id++ {
+		slot := p.aprWindowSlot(id)
+		*slot.LpFeesUSD = FL(0)
+		*slot.ReserveUSDSum = FL(0)
+		*slot.ReserveUSDSamples = IL(0)
+	}
+	p.AprWindowTimestamp = timestamp
+
+	return true
+}
+
+// addAPRSwap accounts for the liquidity provider fees of a swap at `timestamp` and the reserveUSD
+// of the pair once swapped in its 7 days APR window.
+func (p *Pair) addAPRSwap(timestamp int64, lpFeesUSD *big.Float) {
+	p.advanceAPRWindow(timestamp)
+
+	slot := p.aprWindowSlot(DayBucket.ID(timestamp))
+	*slot.LpFeesUSD = entity.FloatAdd(*slot.LpFeesUSD, F(lpFeesUSD))
+	*slot.ReserveUSDSum = entity.FloatAdd(*slot.ReserveUSDSum, p.ReserveUSD)
+	*slot.ReserveUSDSamples = entity.IntAdd(*slot.ReserveUSDSamples, IL(1))
+
+	p.updateAPR()
+}
+
+// mergeAPRWindow folds the 7 days APR window of an earlier shard into the one of a later shard,
+// both moved to end at the latest of their timestamps.
+func (next *Pair) mergeAPRWindow(cached *Pair) {
+	previous := *cached
+	if next.AprWindowTimestamp < previous.AprWindowTimestamp {
+		next.advanceAPRWindow(previous.AprWindowTimestamp)
+	}
+	previous.advanceAPRWindow(next.AprWindowTimestamp)
+
+	slots, previousSlots := next.aprWindowSlots(), previous.aprWindowSlots()
+	for i, slot := range slots {
+		*slot.LpFeesUSD = entity.FloatAdd(*slot.LpFeesUSD, *previousSlots[i].LpFeesUSD)
+		*slot.ReserveUSDSum = entity.FloatAdd(*slot.ReserveUSDSum, *previousSlots[i].ReserveUSDSum)
+		*slot.ReserveUSDSamples = entity.IntAdd(*slot.ReserveUSDSamples, *previousSlots[i].ReserveUSDSamples)
+	}
+}
+
+// updateAPR recomputes `Apr7D` from the window, which is weighted by the elapsed part of its
+// current day.
+func (p *Pair) updateAPR() {
+	lpFeesUSD := bf()
+	reserveUSDSum := bf()
+	reserveUSDSamples := big.NewInt(0)
+	for _, slot := range p.aprWindowSlots() {
+		lpFeesUSD.Add(lpFeesUSD, slot.LpFeesUSD.Float())
+		reserveUSDSum.Add(reserveUSDSum, slot.ReserveUSDSum.Float())
+		reserveUSDSamples.Add(reserveUSDSamples, slot.ReserveUSDSamples.Int())
+	}
+
+	p.Apr7D = F(periodAPR(lpFeesUSD, reserveUSDSum, reserveUSDSamples, aprWindowSeconds(p.AprWindowTimestamp, p.Timestamp.Int().Int64())))
+}
+
+// aprWindowSeconds returns how long the APR window ending at `timestamp` lasted: the previous
+// `aprWindowDays - 1` days plus the elapsed part of the current one, starting at `createdAt` for
+// a pair created since.
+func aprWindowSeconds(timestamp, createdAt int64) int64 {
+	start := DayBucket.Start(timestamp) - (aprWindowDays-1)*DayBucket.Seconds
+	if createdAt > start {
+		start = createdAt
+	}
+
+	return timestamp - start
+}
@@ -0,0 +1,165 @@
+package exchange
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodAPR(t *testing.T) {
+	tests := []struct {
+		name              string
+		lpFeesUSD         float64
+		reserveUSDSum     float64
+		reserveUSDSamples int64
+		periodSeconds     int64
+		expected          float64
+	}{
+		// 10 USD earned on an average of 1000 USD, 365 times a year
+		{"day", 10, 3000, 3, 86400, 3.65},
+		{"hour", 1, 1000, 1, 3600, 8.76},
+		{"no samples", 10, 0, 0, 86400, 0},
+		{"empty reserves", 10, 0, 2, 86400, 0},
+		{"empty period", 10, 1000, 1, 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			apr := periodAPR(big.NewFloat(test.lpFeesUSD), big.NewFloat(test.reserveUSDSum), big.NewInt(test.reserveUSDSamples), test.periodSeconds)
+
+			actual, _ := apr.Float64()
+			assert.InDelta(t, test.expected, actual, 1e-9)
+		})
+	}
+}
+
+func TestAPRToAPY(t *testing.T) {
+	tests := []struct {
+		name          string
+		apr           float64
+		periodSeconds int64
+		expected      float64
+	}{
+		{"zero", 0, 86400, 0},
+		{"yearly", 0.1, secondsPerYear, 0.1},
+		{"daily", 0.365, 86400, 0.44025},
+		{"overflow keeps the apr", 1e12, 3600, 1e12},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, _ := aprToAPY(big.NewFloat(test.apr), test.periodSeconds).Float64()
+			assert.InDelta(t, test.expected, actual, 1e-5)
+		})
+	}
+}
+
+func TestAPRWindowSeconds(t *testing.T) {
+	// 2021-01-10 12:00:00
+	timestamp := int64(1610280000)
+
+	tests := []struct {
+		name      string
+		createdAt int64
+		expected  int64
+	}{
+		{"old pair", 0, 6*86400 + 43200},
+		{"created in the window", timestamp - 86400, 86400},
+		{"created today", timestamp - 60, 60},
+		{"created at the window start", DayBucket.Start(timestamp) - 6*86400, 6*86400 + 43200},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, aprWindowSeconds(timestamp, test.createdAt))
+		})
+	}
+}
+
+// testAPRSwap is a swap of `lpFeesUSD` fees at `timestamp` on a pair holding `reserveUSD`.
+type testAPRSwap struct {
+	timestamp  int64
+	lpFeesUSD  float64
+	reserveUSD float64
+}
+
+func applyAPRSwaps(pair *Pair, swaps []testAPRSwap) {
+	for _, swap := range swaps {
+		pair.ReserveUSD = FL(swap.reserveUSD)
+		pair.addAPRSwap(swap.timestamp, big.NewFloat(swap.lpFeesUSD))
+	}
+}
+
+func TestPair_addAPRSwap(t *testing.T) {
+	// 2021-01-10 00:00:00
+	day := int64(1610236800)
+
+	tests := []struct {
+		name     string
+		swaps    []testAPRSwap
+		expected float64
+	}{
+		// 10 USD earned on 1000 USD over the first 12 hours of a pair
+		{"single day", []testAPRSwap{{day, 4, 1000}, {day + 43200, 6, 1000}}, 10.0 / 1000 * 730},
+		{"whole window", []testAPRSwap{{day, 7, 1000}, {day + 6*86400 + 43200, 7, 1000}}, 14.0 / 1000 * 365 / 6.5},
+		// the first day left the window, the pair now being older than it
+		{"expired day", []testAPRSwap{{day, 100, 1000}, {day + 7*86400 + 43200, 7, 1000}}, 7.0 / 1000 * 365 / 6.5},
+		{"expired window", []testAPRSwap{{day, 100, 1000}, {day + 30*86400 + 43200, 7, 1000}}, 7.0 / 1000 * 365 / 6.5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pair := NewPair("0xpair")
+			pair.Timestamp = IL(day)
+			applyAPRSwaps(pair, test.swaps)
+
+			actual, _ := pair.Apr7D.Float().Float64()
+			assert.InDelta(t, test.expected, actual, 1e-9)
+		})
+	}
+}
+
+func TestPair_advanceAPRWindow(t *testing.T) {
+	// 2021-01-10 12:00:00
+	timestamp := int64(1610280000)
+
+	pair := NewPair("0xpair")
+	pair.addAPRSwap(timestamp, big.NewFloat(10))
+
+	assert.False(t, pair.advanceAPRWindow(timestamp), "same block")
+	assert.False(t, pair.advanceAPRWindow(timestamp-60), "earlier block")
+	assert.True(t, pair.advanceAPRWindow(timestamp+60))
+	assert.Equal(t, "10", pair.aprWindowSlot(DayBucket.ID(timestamp)).LpFeesUSD.String())
+
+	assert.True(t, pair.advanceAPRWindow(timestamp+7*86400))
+	assert.Equal(t, "0", pair.aprWindowSlot(DayBucket.ID(timestamp)).LpFeesUSD.String())
+}
+
+func TestMergeFunc_pairAPRWindow(t *testing.T) {
+	// 2021-01-10 00:00:00
+	day := int64(1610236800)
+
+	swaps := []testAPRSwap{
+		{day, 50, 1000},
+		{day + 43200, 4, 1000},
+		{day + 86400, 2, 3000},
+		{day + 7*86400 + 3600, 6, 2000},
+		{day + 7*86400 + 7200, 1, 2000},
+	}
+
+	for split := 1; split < len(swaps); split++ {
+		linear := NewPair("0xpair")
+		applyAPRSwaps(linear, swaps)
+
+		cached, next := NewPair("0xpair"), NewPair("0xpair")
+		applyAPRSwaps(cached, swaps[:split])
+		applyAPRSwaps(next, swaps[split:])
+		next.MutatedOnStep = Definition.HighestParallelStep
+
+		merged := Definition.MergeFunc(Definition.HighestParallelStep+1, cached, next).(*Pair)
+
+		assert.Equal(t, linear.Apr7D.String(), merged.Apr7D.String(), "split at swap %d", split)
+		assert.Equal(t, linear.AprWindowTimestamp, merged.AprWindowTimestamp, "split at swap %d", split)
+	}
+}
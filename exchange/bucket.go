@@ -0,0 +1,89 @@
+package exchange
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Bucket is a time interval entities are aggregated over. Bucket IDs count the intervals
+// elapsed since `Offset` seconds after the Unix epoch.
+type Bucket struct {
+	Name    string
+	Seconds int64
+	Offset  int64
+}
+
+var (
+	HourBucket = Bucket{Name: "1h", Seconds: 3600}
+	DayBucket  = Bucket{Name: "1d", Seconds: 86400}
+	// the epoch is a thursday, weeks are shifted to start on mondays
+	WeekBucket = Bucket{Name: "1w", Seconds: 7 * 86400, Offset: 4 * 86400}
+)
+
+var buckets = map[string]Bucket{
+	"5m":            {Name: "5m", Seconds: 300},
+	"15m":           {Name: "15m", Seconds: 900},
+	HourBucket.Name: HourBucket,
+	"4h":            {Name: "4h", Seconds: 4 * 3600},
+	DayBucket.Name:  DayBucket,
+	WeekBucket.Name: WeekBucket,
+}
+
+// IntervalBuckets are the granularities `PairIntervalData` and `TokenIntervalData` are
+// emitted at, on top of the hour and day data. None by default, see `ConfigureIntervals`.
+var IntervalBuckets []Bucket
+
+// dataBucket is a bucket pairs and tokens are aggregated over. The `HourBucket` and `DayBucket`
+// are stored as hour and day data, the `IntervalBuckets` as interval data, even when one of them
+// has the same length.
+type dataBucket struct {
+	Bucket
+	interval bool
+}
+
+// dataBuckets returns the hour and day buckets followed by the `IntervalBuckets`.
+func dataBuckets() []dataBucket {
+	buckets := []dataBucket{{Bucket: HourBucket}, {Bucket: DayBucket}}
+	for _, bucket := range IntervalBuckets {
+		buckets = append(buckets, dataBucket{Bucket: bucket, interval: true})
+	}
+
+	return buckets
+}
+
+// ID returns the ID of the bucket holding `timestamp`.
+func (b Bucket) ID(timestamp int64) int64 {
+	return (timestamp - b.Offset) / b.Seconds
+}
+
+// Start returns the start timestamp of the bucket holding `timestamp`.
+func (b Bucket) Start(timestamp int64) int64 {
+	return b.ID(timestamp)*b.Seconds + b.Offset
+}
+
+// BucketNames returns the sorted names of the supported buckets.
+func BucketNames() []string {
+	var names []string
+	for name := range buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ConfigureIntervals sets the `IntervalBuckets` from their names.
+func ConfigureIntervals(names []string) error {
+	var intervals []Bucket
+	for _, name := range names {
+		bucket, found := buckets[name]
+		if !found {
+			return fmt.Errorf("unknown interval %q, valid values are: %s", name, strings.Join(BucketNames(), ", "))
+		}
+		intervals = append(intervals, bucket)
+	}
+
+	IntervalBuckets = intervals
+	return nil
+}
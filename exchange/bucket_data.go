@@ -0,0 +1,280 @@
+package exchange
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/streamingfast/sparkle/entity"
+)
+
+// The hour, day and interval data of pairs and tokens are distinct entities sharing most of
+// their fields. They are updated through the pointers of `pairBucketFields` and
+// `tokenBucketFields`, the fields only some of them have being nil for the others.
+
+// candle points to the open, high, low and close fields of a price candle.
+type candle struct {
+	Open, High, Low, Close *entity.Float
+}
+
+func (c candle) update(price *big.Float) {
+	updateCandle(c.Open, c.High, c.Low, c.Close, price)
+}
+
+func (c candle) merge(cached candle) {
+	mergeCandle(c.Open, c.High, c.Low, c.Close, *cached.Open, *cached.High, *cached.Low, *cached.Close)
+}
+
+// pairBucketData is implemented by `PairHourData`, `PairDayData` and `PairIntervalData`.
+type pairBucketData interface {
+	entity.Interface
+	bucketFields() *pairBucketFields
+}
+
+type pairBucketFields struct {
+	Reserve0, Reserve1, ReserveUSD             *entity.Float
+	Price0CumulativeLast, Price1CumulativeLast *entity.Int
+	BlockTimestampLast                         *int64
+
+	Token0Price, Token1Price, Token0PriceUSD, Token1PriceUSD candle
+
+	VolumeToken0, VolumeToken1, VolumeUSD                       *entity.Float
+	FeesToken0, FeesToken1, FeesUSD, LpFeesUSD, ProtocolFeesUSD *entity.Float
+	TxCount                                                     *entity.Int
+
+	// day data only
+	TotalSupply *entity.Float
+
+	// hour and day data only
+	ReserveUSDSum     *entity.Float
+	ReserveUSDSamples *entity.Int
+}
+
+func (p *PairHourData) bucketFields() *pairBucketFields {
+	return &pairBucketFields{
+		Reserve0:             &p.Reserve0,
+		Reserve1:             &p.Reserve1,
+		ReserveUSD:           &p.ReserveUSD,
+		Price0CumulativeLast: &p.Price0CumulativeLast,
+		Price1CumulativeLast: &p.Price1CumulativeLast,
+		BlockTimestampLast:   &p.BlockTimestampLast,
+		Token0Price:          candle{&p.Token0PriceOpen, &p.Token0PriceHigh, &p.Token0PriceLow, &p.Token0PriceClose},
+		Token1Price:          candle{&p.Token1PriceOpen, &p.Token1PriceHigh, &p.Token1PriceLow, &p.Token1PriceClose},
+		Token0PriceUSD:       candle{&p.Token0PriceUSDOpen, &p.Token0PriceUSDHigh, &p.Token0PriceUSDLow, &p.Token0PriceUSDClose},
+		Token1PriceUSD:       candle{&p.Token1PriceUSDOpen, &p.Token1PriceUSDHigh, &p.Token1PriceUSDLow, &p.Token1PriceUSDClose},
+		VolumeToken0:         &p.VolumeToken0,
+		VolumeToken1:         &p.VolumeToken1,
+		VolumeUSD:            &p.VolumeUSD,
+		FeesToken0:           &p.FeesToken0,
+		FeesToken1:           &p.FeesToken1,
+		FeesUSD:              &p.FeesUSD,
+		LpFeesUSD:            &p.LpFeesUSD,
+		ProtocolFeesUSD:      &p.ProtocolFeesUSD,
+		TxCount:              &p.TxCount,
+		ReserveUSDSum:        &p.ReserveUSDSum,
+		ReserveUSDSamples:    &p.ReserveUSDSamples,
+	}
+}
+
+func (p *PairDayData) bucketFields() *pairBucketFields {
+	return &pairBucketFields{
+		Reserve0:             &p.Reserve0,
+		Reserve1:             &p.Reserve1,
+		ReserveUSD:           &p.ReserveUSD,
+		Price0CumulativeLast: &p.Price0CumulativeLast,
+		Price1CumulativeLast: &p.Price1CumulativeLast,
+		BlockTimestampLast:   &p.BlockTimestampLast,
+		Token0Price:          candle{&p.Token0PriceOpen, &p.Token0PriceHigh, &p.Token0PriceLow, &p.Token0PriceClose},
+		Token1Price:          candle{&p.Token1PriceOpen, &p.Token1PriceHigh, &p.Token1PriceLow, &p.Token1PriceClose},
+		Token0PriceUSD:       candle{&p.Token0PriceUSDOpen, &p.Token0PriceUSDHigh, &p.Token0PriceUSDLow, &p.Token0PriceUSDClose},
+		Token1PriceUSD:       candle{&p.Token1PriceUSDOpen, &p.Token1PriceUSDHigh, &p.Token1PriceUSDLow, &p.Token1PriceUSDClose},
+		VolumeToken0:         &p.VolumeToken0,
+		VolumeToken1:         &p.VolumeToken1,
+		VolumeUSD:            &p.VolumeUSD,
+		FeesToken0:           &p.FeesToken0,
+		FeesToken1:           &p.FeesToken1,
+		FeesUSD:              &p.FeesUSD,
+		LpFeesUSD:            &p.LpFeesUSD,
+		ProtocolFeesUSD:      &p.ProtocolFeesUSD,
+		TxCount:              &p.TxCount,
+		TotalSupply:          &p.TotalSupply,
+		ReserveUSDSum:        &p.ReserveUSDSum,
+		ReserveUSDSamples:    &p.ReserveUSDSamples,
+	}
+}
+
+func (p *PairIntervalData) bucketFields() *pairBucketFields {
+	return &pairBucketFields{
+		Reserve0:             &p.Reserve0,
+		Reserve1:             &p.Reserve1,
+		ReserveUSD:           &p.ReserveUSD,
+		Price0CumulativeLast: &p.Price0CumulativeLast,
+		Price1CumulativeLast: &p.Price1CumulativeLast,
+		BlockTimestampLast:   &p.BlockTimestampLast,
+		Token0Price:          candle{&p.Token0PriceOpen, &p.Token0PriceHigh, &p.Token0PriceLow, &p.Token0PriceClose},
+		Token1Price:          candle{&p.Token1PriceOpen, &p.Token1PriceHigh, &p.Token1PriceLow, &p.Token1PriceClose},
+		Token0PriceUSD:       candle{&p.Token0PriceUSDOpen, &p.Token0PriceUSDHigh, &p.Token0PriceUSDLow, &p.Token0PriceUSDClose},
+		Token1PriceUSD:       candle{&p.Token1PriceUSDOpen, &p.Token1PriceUSDHigh, &p.Token1PriceUSDLow, &p.Token1PriceUSDClose},
+		VolumeToken0:         &p.VolumeToken0,
+		VolumeToken1:         &p.VolumeToken1,
+		VolumeUSD:            &p.VolumeUSD,
+		FeesToken0:           &p.FeesToken0,
+		FeesToken1:           &p.FeesToken1,
+		FeesUSD:              &p.FeesUSD,
+		LpFeesUSD:            &p.LpFeesUSD,
+		ProtocolFeesUSD:      &p.ProtocolFeesUSD,
+		TxCount:              &p.TxCount,
+	}
+}
+
+// getPairBucketData loads the `bucket` data of `pair` for the current block, initializing it
+// when it does not exist yet.
+func (s *Subgraph) getPairBucketData(pair *Pair, bucket dataBucket) (pairBucketData, error) {
+	timestamp := s.Block().Timestamp().Unix()
+
+	var newData func() pairBucketData
+	switch {
+	case bucket.interval:
+		id := fmt.Sprintf("%s-%s-%d", pair.ID, bucket.Name, bucket.ID(timestamp))
+		newData = func() pairBucketData {
+			data := NewPairIntervalData(id)
+			data.Interval = bucket.Name
+			data.Date = bucket.Start(timestamp)
+			data.Pair = pair.ID
+			return data
+		}
+	case bucket.Bucket == DayBucket:
+		id := fmt.Sprintf("%s-%d", pair.ID, bucket.ID(timestamp))
+		newData = func() pairBucketData {
+			data := NewPairDayData(id)
+			data.Date = bucket.Start(timestamp)
+			data.Token0 = pair.Token0
+			data.Token1 = pair.Token1
+			data.Pair = pair.ID
+			return data
+		}
+	default:
+		id := fmt.Sprintf("%s-%d", pair.ID, bucket.ID(timestamp))
+		newData = func() pairBucketData {
+			data := NewPairHourData(id)
+			data.Date = bucket.Start(timestamp)
+			data.Pair = pair.ID
+			return data
+		}
+	}
+
+	data := newData()
+	if err := s.Load(data); err != nil {
+		return nil, fmt.Errorf("loading pair %s data %s: %w", bucket.Name, data.GetID(), err)
+	}
+
+	if !data.Exists() {
+		data = newData()
+	}
+
+	return data, nil
+}
+
+// tokenBucketData is implemented by `TokenHourData`, `TokenDayData` and `TokenIntervalData`.
+type tokenBucketData interface {
+	entity.Interface
+	bucketFields() *tokenBucketFields
+}
+
+type tokenBucketFields struct {
+	Volume, VolumeETH, VolumeUSD *entity.Float
+	TxCount                      *entity.Int
+
+	Liquidity, LiquidityETH, LiquidityUSD *entity.Float
+	PriceUSD                              *entity.Float
+
+	// day data only
+	TotalSupply  *entity.Int
+	MarketCapUSD *entity.Float
+}
+
+func (t *TokenHourData) bucketFields() *tokenBucketFields {
+	return &tokenBucketFields{
+		Volume:       &t.Volume,
+		VolumeETH:    &t.VolumeETH,
+		VolumeUSD:    &t.VolumeUSD,
+		TxCount:      &t.TxCount,
+		Liquidity:    &t.Liquidity,
+		LiquidityETH: &t.LiquidityETH,
+		LiquidityUSD: &t.LiquidityUSD,
+		PriceUSD:     &t.PriceUSD,
+	}
+}
+
+func (t *TokenDayData) bucketFields() *tokenBucketFields {
+	return &tokenBucketFields{
+		Volume:       &t.Volume,
+		VolumeETH:    &t.VolumeETH,
+		VolumeUSD:    &t.VolumeUSD,
+		TxCount:      &t.TxCount,
+		Liquidity:    &t.Liquidity,
+		LiquidityETH: &t.LiquidityETH,
+		LiquidityUSD: &t.LiquidityUSD,
+		PriceUSD:     &t.PriceUSD,
+		TotalSupply:  &t.TotalSupply,
+		MarketCapUSD: &t.MarketCapUSD,
+	}
+}
+
+func (t *TokenIntervalData) bucketFields() *tokenBucketFields {
+	return &tokenBucketFields{
+		Volume:       &t.Volume,
+		VolumeETH:    &t.VolumeETH,
+		VolumeUSD:    &t.VolumeUSD,
+		TxCount:      &t.TxCount,
+		Liquidity:    &t.Liquidity,
+		LiquidityETH: &t.LiquidityETH,
+		LiquidityUSD: &t.LiquidityUSD,
+		PriceUSD:     &t.PriceUSD,
+	}
+}
+
+// getTokenBucketData loads the `bucket` data of `token` for the current block, initializing it
+// when it does not exist yet.
+func (s *Subgraph) getTokenBucketData(token *Token, bucket dataBucket) (tokenBucketData, error) {
+	timestamp := s.Block().Timestamp().Unix()
+
+	var newData func() tokenBucketData
+	switch {
+	case bucket.interval:
+		id := fmt.Sprintf("%s-%s-%d", token.ID, bucket.Name, bucket.ID(timestamp))
+		newData = func() tokenBucketData {
+			data := NewTokenIntervalData(id)
+			data.Interval = bucket.Name
+			data.Date = bucket.Start(timestamp)
+			data.Token = token.ID
+			return data
+		}
+	case bucket.Bucket == DayBucket:
+		id := fmt.Sprintf("%s-%d", token.ID, bucket.ID(timestamp))
+		newData = func() tokenBucketData {
+			data := NewTokenDayData(id)
+			data.Date = bucket.Start(timestamp)
+			data.Token = token.ID
+			return data
+		}
+	default:
+		id := fmt.Sprintf("%s-%d", token.ID, bucket.ID(timestamp))
+		newData = func() tokenBucketData {
+			data := NewTokenHourData(id)
+			data.Date = bucket.Start(timestamp)
+			data.Token = token.ID
+			return data
+		}
+	}
+
+	data := newData()
+	if err := s.Load(data); err != nil {
+		return nil, fmt.Errorf("loading token %s data %s: %w", bucket.Name, data.GetID(), err)
+	}
+
+	if !data.Exists() {
+		data = newData()
+	}
+
+	return data, nil
+}
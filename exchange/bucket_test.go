@@ -0,0 +1,33 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucket_IDAndStart(t *testing.T) {
+	tests := []struct {
+		name          string
+		bucket        Bucket
+		timestamp     int64
+		expectedID    int64
+		expectedStart int64
+	}{
+		{"hour at epoch", HourBucket, 0, 0, 0},
+		{"hour start", HourBucket, 7200, 2, 7200},
+		{"hour end", HourBucket, 7199, 1, 3600},
+		{"day", DayBucket, 1609459200 + 3600, 18628, 1609459200},
+		{"5m", buckets["5m"], 1000, 3, 900},
+		// 2021-01-04 is a monday
+		{"week on monday", WeekBucket, 1609718400, 2661, 1609718400},
+		{"week on sunday", WeekBucket, 1609718400 - 1, 2660, 1609718400 - 7*86400},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedID, test.bucket.ID(test.timestamp))
+			assert.Equal(t, test.expectedStart, test.bucket.Start(test.timestamp))
+		})
+	}
+}
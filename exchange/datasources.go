@@ -0,0 +1,155 @@
+package exchange
+
+import (
+	"bytes"
+	"fmt"
+
+	eth "github.com/streamingfast/eth-go"
+	pbcodec "github.com/streamingfast/sparkle/pb/dfuse/ethereum/codec/v1"
+	"github.com/streamingfast/sparkle/subgraph"
+)
+
+// Sparkle only generates the events of the first data source of the manifest (the factory) and
+// of its templates. The events of the other data sources are decoded here and `HandleBlock`
+// replaces the generated one to dispatch the events of all the data sources in block order.
+
+// dataSourceDecoder decodes the logs emitted by the contract of a static data source, it
+// returns a nil event for logs it does not know about.
+type dataSourceDecoder func(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (interface{}, error)
+
+type blockHandler struct {
+	*Subgraph
+}
+
+func init() {
+	generatedNew := Definition.New
+	Definition.New = func(base subgraph.Base) subgraph.Subgraph {
+		return &blockHandler{Subgraph: generatedNew(base).(*Subgraph)}
+	}
+}
+
+// LoadDynamicDataSources registers the additional factories of the network along the pairs,
+// `HandleBlock` then dispatches their `PairCreated` events like the ones of the network
+// factory, including the events of pairs created in the same block. They are only kept in
+// memory, `Init` skipping them as they are not pairs.
+func (h *blockHandler) LoadDynamicDataSources(blockNum uint64) error {
+	if err := h.Subgraph.LoadDynamicDataSources(blockNum); err != nil {
+		return err
+	}
+
+	for _, factory := range network.AdditionalFactories {
+		h.DynamicDataSources[factory.Address] = NewDynamicDataSource(factory.Address, "Factory", "")
+	}
+
+	return nil
+}
+
+// dataSourceDecoders returns the decoders of the static data sources configured on the active
+// network, keyed by contract address.
+func dataSourceDecoders() map[string]dataSourceDecoder {
+	decoders := map[string]dataSourceDecoder{}
+	if network.DistributorAddress != "" {
+		decoders[network.DistributorAddress] = decodeDistributorEvent
+	}
+	if network.SushiTokenAddress != "" {
+		decoders[network.SushiTokenAddress] = decodeSushiTokenEvent
+	}
+	if network.MasterChefAddress != "" {
+		decoders[network.MasterChefAddress] = decodeMasterChefEvent
+	}
+
+	return decoders
+}
+
+// HandleBlock dispatches the events of the block in a single pass, ordered by transaction and
+// log index whatever their data source. Unlike the generated version, the events of the pairs
+// created in the block are handled in that same pass, right after their `PairCreated` event.
+func (h *blockHandler) HandleBlock(block *pbcodec.Block) error {
+	decoders := dataSourceDecoders()
+
+	idx := uint32(0)
+	h.CurrentBlockDynamicDataSources = make(map[string]*DynamicDataSourceXXX)
+
+	for _, trace := range block.TransactionTraces {
+		for _, log := range trace.Logs() {
+			eventLog := codecLogToEthLog(log, idx)
+			idx++
+
+			address := eth.Address(log.Address).Pretty()
+			if bytes.Equal(FactoryAddressBytes, log.Address) || h.IsDynamicDataSource(address) || h.IsCurrentDynamicDataSource(address) {
+				ev, err := DecodeEvent(eventLog, block, trace)
+				if err != nil {
+					return fmt.Errorf("parsing event: %w", err)
+				}
+				if err := h.HandleEvent(ev); err != nil {
+					return fmt.Errorf("handling event: %w", err)
+				}
+				continue
+			}
+
+			decode, found := decoders[address]
+			if !found || len(log.Topics) == 0 {
+				continue
+			}
+
+			ev, err := decode(eventLog, block, trace)
+			if err != nil {
+				return fmt.Errorf("parsing event: %w", err)
+			}
+			if ev == nil {
+				continue
+			}
+
+			if err := h.HandleDataSourceEvent(ev); err != nil {
+				return fmt.Errorf("handling event: %w", err)
+			}
+		}
+	}
+
+	for k, v := range h.CurrentBlockDynamicDataSources {
+		h.DynamicDataSources[k] = v
+	}
+
+	return nil
+}
+
+// HandleDataSourceEvent is the counterpart of the generated `HandleEvent` for the events of the
+// non-generated data sources.
+func (s *Subgraph) HandleDataSourceEvent(ev interface{}) error {
+	switch e := ev.(type) {
+	case *DistributorClaimedEvent:
+		if err := s.HandleDistributorClaimedEvent(e); err != nil {
+			return fmt.Errorf("handling DistributorClaimed event: %w", err)
+		}
+	case *DistributorMerkleRootUpdatedEvent:
+		if err := s.HandleDistributorMerkleRootUpdatedEvent(e); err != nil {
+			return fmt.Errorf("handling DistributorMerkleRootUpdated event: %w", err)
+		}
+	case *SushiTokenTransferEvent:
+		if err := s.HandleSushiTokenTransferEvent(e); err != nil {
+			return fmt.Errorf("handling SushiTokenTransfer event: %w", err)
+		}
+	case *SushiTokenDelegateChangedEvent:
+		if err := s.HandleSushiTokenDelegateChangedEvent(e); err != nil {
+			return fmt.Errorf("handling SushiTokenDelegateChanged event: %w", err)
+		}
+	case *SushiTokenDelegateVotesChangedEvent:
+		if err := s.HandleSushiTokenDelegateVotesChangedEvent(e); err != nil {
+			return fmt.Errorf("handling SushiTokenDelegateVotesChanged event: %w", err)
+		}
+	case *MasterChefDepositEvent:
+		if err := s.HandleMasterChefDepositEvent(e); err != nil {
+			return fmt.Errorf("handling MasterChefDeposit event: %w", err)
+		}
+	case *MasterChefWithdrawEvent:
+		if err := s.HandleMasterChefWithdrawEvent(e); err != nil {
+			return fmt.Errorf("handling MasterChefWithdraw event: %w", err)
+		}
+	case *MasterChefEmergencyWithdrawEvent:
+		if err := s.HandleMasterChefEmergencyWithdrawEvent(e); err != nil {
+			return fmt.Errorf("handling MasterChefEmergencyWithdraw event: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package exchange
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	eth "github.com/streamingfast/eth-go"
+	"github.com/streamingfast/sparkle/entity"
+	pbcodec "github.com/streamingfast/sparkle/pb/dfuse/ethereum/codec/v1"
+)
+
+// Events of the `SushiDistributor` data source, written after the generated ones, see
+// `datasources.go`.
+
+func decodeDistributorEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (interface{}, error) {
+	if IsDistributorClaimedEvent(log) {
+		ev, err := NewDistributorClaimedEvent(log, block, trace)
+		if err != nil {
+			return nil, fmt.Errorf("decoding DistributorClaimed event: %w", err)
+		}
+		return ev, nil
+	}
+
+	if IsDistributorMerkleRootUpdatedEvent(log) {
+		ev, err := NewDistributorMerkleRootUpdatedEvent(log, block, trace)
+		if err != nil {
+			return nil, fmt.Errorf("decoding DistributorMerkleRootUpdated event: %w", err)
+		}
+		return ev, nil
+	}
+
+	return nil, nil
+}
+
+// DistributorClaimed event
+
+type DistributorClaimedEvent struct {
+	*entity.BaseEvent
+	LogAddress eth.Address
+	LogIndex   int
+
+	// Fields
+	Index   *big.Int    `eth:""`
+	Amount  *big.Int    `eth:""`
+	Account eth.Address `eth:",indexed"`
+	Week    *big.Int    `eth:",indexed"`
+}
+
+var hashDistributorClaimedEvent = eth.Keccak256([]byte("Claimed(uint256,uint256,address,uint256)"))
+
+func IsDistributorClaimedEvent(log *eth.Log) bool {
+	return bytes.Equal(log.Topics[0], hashDistributorClaimedEvent)
+}
+
+func NewDistributorClaimedEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (*DistributorClaimedEvent, error) {
+	var err error
+	ev := &DistributorClaimedEvent{
+		BaseEvent:  &entity.BaseEvent{},
+		LogAddress: log.Address,
+		LogIndex:   int(log.BlockIndex),
+	}
+
+	ev.SetBlockAndTransaction(block, trace)
+
+	dec := eth.NewLogDecoder(log)
+	if _, err := dec.ReadTopic(); err != nil {
+		return nil, fmt.Errorf("reading topic 0: %w", err)
+	}
+	f0, err := dec.ReadTypedTopic("address")
+	if err != nil {
+		return nil, fmt.Errorf("reading account: %w", err)
+	}
+	ev.Account = f0.(eth.Address)
+	f1, err := dec.ReadTypedTopic("uint256")
+	if err != nil {
+		return nil, fmt.Errorf("reading week: %w", err)
+	}
+	ev.Week = f1.(*big.Int)
+	ev.Index, err = dec.DataDecoder.ReadBigInt()
+	if err != nil {
+		return nil, fmt.Errorf("reading index: %w", err)
+	}
+	ev.Amount, err = dec.DataDecoder.ReadBigInt()
+	if err != nil {
+		return nil, fmt.Errorf("reading amount: %w", err)
+	}
+	return ev, nil
+}
+
+// DistributorMerkleRootUpdated event
+
+type DistributorMerkleRootUpdatedEvent struct {
+	*entity.BaseEvent
+	LogAddress eth.Address
+	LogIndex   int
+
+	// Fields
+	MerkleRoot eth.Hash `eth:",indexed"`
+	Week       uint32   `eth:",indexed"`
+}
+
+var hashDistributorMerkleRootUpdatedEvent = eth.Keccak256([]byte("MerkleRootUpdated(bytes32,uint32)"))
+
+func IsDistributorMerkleRootUpdatedEvent(log *eth.Log) bool {
+	return bytes.Equal(log.Topics[0], hashDistributorMerkleRootUpdatedEvent)
+}
+
+func NewDistributorMerkleRootUpdatedEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (*DistributorMerkleRootUpdatedEvent, error) {
+	ev := &DistributorMerkleRootUpdatedEvent{
+		BaseEvent:  &entity.BaseEvent{},
+		LogAddress: log.Address,
+		LogIndex:   int(log.BlockIndex),
+	}
+
+	ev.SetBlockAndTransaction(block, trace)
+
+	dec := eth.NewLogDecoder(log)
+	if _, err := dec.ReadTopic(); err != nil {
+		return nil, fmt.Errorf("reading topic 0: %w", err)
+	}
+	// the decoder does not handle `bytes32`, the topic is the raw value
+	f0, err := dec.ReadTopic()
+	if err != nil {
+		return nil, fmt.Errorf("reading merkleRoot: %w", err)
+	}
+	ev.MerkleRoot = eth.Hash(f0)
+	f1, err := dec.ReadTypedTopic("uint32")
+	if err != nil {
+		return nil, fmt.Errorf("reading week: %w", err)
+	}
+	ev.Week = f1.(uint32)
+	return ev, nil
+}
@@ -19,40 +19,95 @@ func (p *Pair) Sanitize() {
 }
 
 func (h *HourData) IsFinal(blockNum uint64, blockTime time.Time) bool {
-	hourId := blockTime.Unix() / 3600
-	activeId := strconv.FormatInt(hourId, 10)
+	hourId := HourBucket.ID(blockTime.Unix())
+	activeId := factoryDataID(h.Factory, hourId)
 
 	return h.ID != activeId
 }
 
 func (d *DayData) IsFinal(blockNum uint64, blockTime time.Time) bool {
-	dayId := blockTime.Unix() / 86400
-	activeId := strconv.FormatInt(dayId, 10)
+	dayId := DayBucket.ID(blockTime.Unix())
+	activeId := factoryDataID(d.Factory, dayId)
 
 	return d.ID != activeId
 }
 
+func (t *TokenHourData) IsFinal(blockNum uint64, blockTime time.Time) bool {
+	hourId := HourBucket.ID(blockTime.Unix())
+	activeId := fmt.Sprintf("%s-%d", t.Token, hourId)
+
+	return t.ID != activeId
+}
+
 func (t *TokenDayData) IsFinal(blockNum uint64, blockTime time.Time) bool {
-	dayId := blockTime.Unix() / 86400
+	dayId := DayBucket.ID(blockTime.Unix())
 	activeId := fmt.Sprintf("%s-%d", t.Token, dayId)
 
 	return t.ID != activeId
 }
 
 func (p *PairHourData) IsFinal(blockNum uint64, blockTime time.Time) bool {
-	hourId := blockTime.Unix() / 3600
+	hourId := HourBucket.ID(blockTime.Unix())
 	activeId := fmt.Sprintf("%s-%d", p.Pair, hourId)
 
 	return p.ID != activeId
 }
 
 func (p *PairDayData) IsFinal(blockNum uint64, blockTime time.Time) bool {
-	dayId := blockTime.Unix() / 86400
+	dayId := DayBucket.ID(blockTime.Unix())
 	activeId := fmt.Sprintf("%s-%d", p.Pair, dayId)
 
 	return p.ID != activeId
 }
 
+func (t *TokenIntervalData) IsFinal(blockNum uint64, blockTime time.Time) bool {
+	bucket, found := buckets[t.Interval]
+	if !found {
+		return true
+	}
+	activeId := fmt.Sprintf("%s-%s-%d", t.Token, t.Interval, bucket.ID(blockTime.Unix()))
+
+	return t.ID != activeId
+}
+
+func (p *PairIntervalData) IsFinal(blockNum uint64, blockTime time.Time) bool {
+	bucket, found := buckets[p.Interval]
+	if !found {
+		return true
+	}
+	activeId := fmt.Sprintf("%s-%s-%d", p.Pair, p.Interval, bucket.ID(blockTime.Unix()))
+
+	return p.ID != activeId
+}
+
+func (e *EthPriceHourData) IsFinal(blockNum uint64, blockTime time.Time) bool {
+	hourId := HourBucket.ID(blockTime.Unix())
+	activeId := strconv.FormatInt(hourId, 10)
+
+	return e.ID != activeId
+}
+
+func (e *EthPriceDayData) IsFinal(blockNum uint64, blockTime time.Time) bool {
+	dayId := DayBucket.ID(blockTime.Unix())
+	activeId := strconv.FormatInt(dayId, 10)
+
+	return e.ID != activeId
+}
+
+func (d *SushiSupplyDayData) IsFinal(blockNum uint64, blockTime time.Time) bool {
+	dayId := DayBucket.ID(blockTime.Unix())
+	activeId := strconv.FormatInt(dayId, 10)
+
+	return d.ID != activeId
+}
+
+func (d *SushiHolderDayData) IsFinal(blockNum uint64, blockTime time.Time) bool {
+	dayId := DayBucket.ID(blockTime.Unix())
+	activeId := fmt.Sprintf("%s-%d", d.Holder, dayId)
+
+	return d.ID != activeId
+}
+
 func (t *Transaction) IsFinal(blockNum uint64, blockTime time.Time) bool {
 	return true
 }
@@ -1,9 +1,13 @@
 package exchange
 
-import "strconv"
+import (
+	"github.com/streamingfast/sparkle/entity"
+)
 
-func (s *Subgraph) getFactory() (*Factory, error) {
-	factory := NewFactory(FactoryAddress)
+// getFactory loads the factory at `address`, the network factory or one of its additional
+// factories.
+func (s *Subgraph) getFactory(address string) (*Factory, error) {
+	factory := NewFactory(address)
 	err := s.Load(factory)
 	if err != nil {
 		return nil, err
@@ -18,19 +22,19 @@ func (s *Subgraph) getFactory() (*Factory, error) {
 	return factory, nil
 }
 
-func (s *Subgraph) getDayData() (*DayData, error) {
+func (s *Subgraph) getDayData(factoryAddress string) (*DayData, error) {
 	timestamp := s.Block().Timestamp().Unix()
-	dayId := timestamp / 86400
-	dayStartTimestamp := dayId * 86400
+	dayId := DayBucket.ID(timestamp)
+	dayStartTimestamp := DayBucket.Start(timestamp)
 
-	dayData := NewDayData(strconv.FormatInt(dayId, 10))
+	dayData := NewDayData(factoryDataID(factoryAddress, dayId))
 	err := s.Load(dayData)
 	if err != nil {
 		return nil, err
 	}
 
 	if !dayData.Exists() {
-		factory, err := s.getFactory()
+		factory, err := s.getFactory(factoryAddress)
 		if err != nil {
 			return nil, err
 		}
@@ -39,26 +43,25 @@ func (s *Subgraph) getDayData() (*DayData, error) {
 		dayData.LiquidityETH = factory.LiquidityETH
 		dayData.LiquidityUSD = factory.LiquidityUSD
 		dayData.Factory = factory.ID
-		dayData.TxCount = factory.TxCount
 	}
 
 	return dayData, nil
 }
 
-func (s *Subgraph) updateDayData() error {
-	factory, err := s.getFactory()
+func (s *Subgraph) updateDayData(factoryAddress string) error {
+	factory, err := s.getFactory(factoryAddress)
 	if err != nil {
 		return err
 	}
 
-	dayData, err := s.getDayData()
+	dayData, err := s.getDayData(factoryAddress)
 	if err != nil {
 		return err
 	}
 
 	dayData.LiquidityETH = factory.LiquidityETH
 	dayData.LiquidityUSD = factory.LiquidityUSD
-	dayData.TxCount = factory.TxCount
+	dayData.TxCount = entity.IntAdd(dayData.TxCount, IL(1))
 
 	err = s.Save(dayData)
 	if err != nil {
@@ -46,21 +46,38 @@ var Definition = &subgraph.Definition{
 	Entities: entity.NewRegistry(
 		&User{},
 		&Bundle{},
+		&EthPriceHourData{},
+		&EthPriceDayData{},
+		&EthPriceSourceData{},
+		&PriceAnomaly{},
 		&Factory{},
 		&HourData{},
 		&DayData{},
 		&Token{},
 		&TokenHourData{},
 		&TokenDayData{},
+		&TokenIntervalData{},
 		&Pair{},
 		&PairHourData{},
 		&PairDayData{},
+		&PairIntervalData{},
 		&LiquidityPosition{},
+		&LPAllowance{},
 		&LiquidityPositionSnapshot{},
 		&Transaction{},
 		&Mint{},
 		&Burn{},
 		&Swap{},
+		&Claim{},
+		&MerkleRoot{},
+		&SushiSupply{},
+		&SushiHolder{},
+		&SushiSupplyDayData{},
+		&SushiHolderDayData{},
+		&SushiDelegate{},
+		&SushiVoteChange{},
+		&MasterChefPool{},
+		&MasterChefStake{},
 		&DynamicDataSourceXXX{},
 	),
 	DDL: ddl,
@@ -100,6 +117,83 @@ dataSources:
       eventHandlers:
         - event: PairCreated(indexed address,indexed address,address,uint256)
           handler: onPairCreated
+  - kind: ethereum/contract
+    name: SushiDistributor
+    network: mainnet
+    source:
+      address: '0xcbe6b83e77cdc011cc18f6f0df8444e5783ed982'
+      abi: SushiDistributor
+      startBlock: 10794229
+    mapping:
+      kind: ethereum/events
+      apiVersion: 0.0.4
+      language: wasm/assemblyscript
+      file: ./src/exchange/mappings/distributor.ts
+      entities:
+        - Claim
+        - MerkleRoot
+      abis:
+        - name: SushiDistributor
+          file: ../abis/SushiDistributor.json
+      eventHandlers:
+        - event: Claimed(uint256,uint256,indexed address,indexed uint256)
+          handler: onClaimed
+        - event: MerkleRootUpdated(indexed bytes32,indexed uint32)
+          handler: onMerkleRootUpdated
+  - kind: ethereum/contract
+    name: SushiToken
+    network: mainnet
+    source:
+      address: '0x6b3595068778dd592e39a122f4f5a5cf09c90fe2'
+      abi: SushiToken
+      startBlock: 10736242
+    mapping:
+      kind: ethereum/events
+      apiVersion: 0.0.4
+      language: wasm/assemblyscript
+      file: ./src/exchange/mappings/sushi-token.ts
+      entities:
+        - SushiDelegate
+        - SushiHolder
+        - SushiSupply
+        - SushiVoteChange
+      abis:
+        - name: SushiToken
+          file: ../abis/SushiToken.json
+      eventHandlers:
+        - event: Transfer(indexed address,indexed address,uint256)
+          handler: onTransfer
+        - event: DelegateChanged(indexed address,indexed address,indexed address)
+          handler: onDelegateChanged
+        - event: DelegateVotesChanged(indexed address,uint256,uint256)
+          handler: onDelegateVotesChanged
+  - kind: ethereum/contract
+    name: MasterChef
+    network: mainnet
+    source:
+      address: '0xc2edad668740f1aa35e4d8f227fb8e17dca888cd'
+      abi: MasterChef
+      startBlock: 10736242
+    mapping:
+      kind: ethereum/events
+      apiVersion: 0.0.4
+      language: wasm/assemblyscript
+      file: ./src/exchange/mappings/master-chef.ts
+      entities:
+        - LiquidityPosition
+        - MasterChefPool
+        - MasterChefStake
+        - User
+      abis:
+        - name: MasterChef
+          file: ../abis/MasterChef.json
+      eventHandlers:
+        - event: Deposit(indexed address,indexed uint256,uint256)
+          handler: onDeposit
+        - event: Withdraw(indexed address,indexed uint256,uint256)
+          handler: onWithdraw
+        - event: EmergencyWithdraw(indexed address,indexed uint256,uint256)
+          handler: onEmergencyWithdraw
 templates:
   - kind: ethereum/contract
     name: Pair
@@ -115,9 +209,11 @@ templates:
         - Bundle
         - Burn
         - LiquidityPosition
+        - LPAllowance
         - LiquidityPositionSnapshot
         - Mint
         - Pair
+        - PriceAnomaly
         - Swap
         - Sync
         - Token
@@ -139,6 +235,8 @@ templates:
           handler: onTransfer
         - event: Sync(uint112,uint112)
           handler: onSync
+        - event: Approval(indexed address,indexed address,uint256)
+          handler: onApproval
 `,
 	GraphQLSchema: `# # Search
 # type _Schema_
@@ -166,6 +264,18 @@ type User @entity {
 
   # Liquidity Positions
   liquidityPositions: [LiquidityPosition!]! @derivedFrom(field: "user")
+
+  # swaps received, in tracked USD volume
+  usdSwapped: BigDecimal! @parallel(step: 4, type: SUM)
+  swapCount: BigInt! @parallel(step: 4, type: SUM)
+
+  # liquidity positions with a positive balance
+  activePositionCount: BigInt! @parallel(step: 4, type: SUM)
+
+  # first and last blocks the user took part in a swap, mint, burn or transfer, the first
+  # one is merged as the minimum across parallel shards
+  firstSeenBlock: Int! @parallel(step: 4)
+  lastActiveBlock: Int! @parallel(step: 4)
 }
 
 # Bundle
@@ -174,6 +284,89 @@ type Bundle @entity {
 
   # price of ETH usd
   ethPrice: BigDecimal! @parallel(step: 4)
+
+  # block at which ethPrice was last accepted
+  ethPriceBlock: Int! @parallel(step: 4)
+
+  # price deviating from ethPrice waiting to be confirmed, and the block it was first computed
+  # at, zero when none
+  pendingEthPrice: BigDecimal! @parallel(step: 4)
+  pendingEthPriceBlock: Int! @parallel(step: 4)
+}
+
+# ETH price hour data
+type EthPriceHourData @entity {
+  # hour start timestamp / 3600
+  id: ID!
+
+  # hour start timestamp
+  date: Int! @parallel(step: 4)
+
+  # price of ETH usd over the hour
+  open: BigDecimal! @parallel(step: 4)
+  high: BigDecimal! @parallel(step: 4)
+  low: BigDecimal! @parallel(step: 4)
+  close: BigDecimal! @parallel(step: 4)
+
+  # reference pairs breakdown of the close price
+  sources: [EthPriceSourceData!]! @derivedFrom(field: "hourData")
+}
+
+# ETH price day data
+type EthPriceDayData @entity {
+  # day start timestamp / 86400
+  id: ID!
+
+  # day start timestamp
+  date: Int! @parallel(step: 4)
+
+  # price of ETH usd over the day
+  open: BigDecimal! @parallel(step: 4)
+  high: BigDecimal! @parallel(step: 4)
+  low: BigDecimal! @parallel(step: 4)
+  close: BigDecimal! @parallel(step: 4)
+
+  # reference pairs breakdown of the close price
+  sources: [EthPriceSourceData!]! @derivedFrom(field: "dayData")
+}
+
+# ETH price given by a reference pair at the last update of an hour or a day
+type EthPriceSourceData @entity {
+  # pair - "hour" or "day" - hour or day id
+  id: ID!
+
+  # either set depending on the period
+  hourData: EthPriceHourData @parallel(step: 4)
+  dayData: EthPriceDayData @parallel(step: 4)
+
+  pair: Pair! @parallel(step: 4)
+
+  # price of ETH usd given by the pair and its ETH reserve, used as weight
+  price: BigDecimal! @parallel(step: 4)
+  liquidityETH: BigDecimal! @parallel(step: 4)
+
+  # whether the pair contributed to the close price
+  used: Boolean! @parallel(step: 4)
+}
+
+# First ETH price update rejected by the outlier guard in a block, the bundle kept its
+# previous price
+type PriceAnomaly @entity {
+  # block number
+  id: ID!
+  block: Int! @parallel(step: 4)
+  timestamp: BigInt! @parallel(step: 4)
+  transaction: String! @parallel(step: 4)
+
+  # pair whose sync triggered the first rejected update of the block
+  pair: Pair! @parallel(step: 4)
+
+  # kept and rejected ETH prices in USD
+  oldPrice: BigDecimal! @parallel(step: 4)
+  newPrice: BigDecimal! @parallel(step: 4)
+
+  # either "zero" or "deviation"
+  reason: String! @parallel(step: 4)
 }
 
 # Factory
@@ -193,6 +386,11 @@ type Factory @entity {
   # Untracked volume
   untrackedVolumeUSD: BigDecimal! @parallel(step: 4, type: SUM)
 
+  # Fees USD, split between liquidity providers and the protocol (xSUSHI)
+  feesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  lpFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  protocolFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+
   # Liquidity USD
   liquidityUSD: BigDecimal! @parallel(step: 4)
 
@@ -223,7 +421,7 @@ type Factory @entity {
 
 # Hour Data
 type HourData @entity {
-  # start of hour timestamp
+  # start of hour timestamp, prefixed by "factory address-" for additional factories
   id: ID!
 
   # date
@@ -241,13 +439,13 @@ type HourData @entity {
   liquidityETH: BigDecimal! @parallel(step: 4)
   liquidityUSD: BigDecimal! @parallel(step: 4)
 
-  # tx count
+  # transactions in the hour, not the factory total
   txCount: BigInt! @parallel(step: 4, type: SUM)
 }
 
 # Day Data
 type DayData @entity {
-  # timestamp / 86400
+  # timestamp / 86400, prefixed by "factory address-" for additional factories
   id: ID!
 
   # date
@@ -261,11 +459,16 @@ type DayData @entity {
   volumeUSD: BigDecimal! @parallel(step: 4, type: SUM)
   untrackedVolume: BigDecimal! @parallel(step: 4, type: SUM)
 
+  # fees
+  feesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  lpFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  protocolFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+
   # liquidity
   liquidityETH: BigDecimal! @parallel(step: 4)
   liquidityUSD: BigDecimal! @parallel(step: 4)
 
-  # tx count
+  # transactions in the day, not the factory total
   txCount: BigInt! @parallel(step: 4, type: SUM)
 }
 
@@ -281,9 +484,11 @@ type Token @entity {
   symbol: String! @parallel(step: 1)
   name: String! @parallel(step: 1)
   decimals: BigInt! @parallel(step: 1)
+  # false when the contract reports more than 255 decimals, the token is then never priced
+  decimalsValid: Boolean! @parallel(step: 1)
 
-  # used for other stats like marketcap
-  totalSupply: BigInt!  @parallel(step: 4, type: SUM)
+  # used for other stats like marketcap, refreshed from the contract once per day
+  totalSupply: BigInt!  @parallel(step: 4)
 
   # token specific volume
   volume: BigDecimal!  @parallel(step: 4, type: SUM)
@@ -298,6 +503,10 @@ type Token @entity {
 
   derivedETH: BigDecimal! @parallel(step: 4)
 
+  # pairs the derived ETH price was computed through, from the token towards the native token
+  derivedETHRoute: [Pair!]! @parallel(step: 4)
+  derivedETHHops: Int! @parallel(step: 4)
+
   whitelistPairs: [Pair!]! @parallel(step: 1)
 
   # Token hour data
@@ -373,6 +582,43 @@ type TokenDayData @entity {
 
   # price usd
   priceUSD: BigDecimal! @parallel(step: 4)
+
+  # supply as read from the contract on the first update of the day
+  totalSupply: BigInt! @parallel(step: 4)
+
+  # market cap usd
+  marketCapUSD: BigDecimal! @parallel(step: 4)
+}
+
+# Token data over a configured interval (5m, 15m, 4h, 1w, ...)
+type TokenIntervalData @entity {
+  # token id - interval - bucket id
+  id: ID!
+
+  # interval name
+  interval: String! @parallel(step: 4)
+
+  # date - bucket start timestamp
+  date: Int! @parallel(step: 4)
+
+  # token
+  token: Token! @parallel(step: 4)
+
+  # volume
+  volume: BigDecimal! @parallel(step: 4, type: SUM)
+  volumeETH: BigDecimal! @parallel(step: 4, type: SUM)
+  volumeUSD: BigDecimal! @parallel(step: 4, type: SUM)
+
+  # tx count
+  txCount: BigInt! @parallel(step: 4, type: SUM)
+
+  # liquidity
+  liquidity: BigDecimal! @parallel(step: 4)
+  liquidityETH: BigDecimal! @parallel(step: 4)
+  liquidityUSD: BigDecimal! @parallel(step: 4)
+
+  # price usd
+  priceUSD: BigDecimal! @parallel(step: 4)
 }
 
 # Pair
@@ -405,6 +651,11 @@ type Pair @entity {
   token0Price: BigDecimal! @parallel(step: 2)
   token1Price: BigDecimal! @parallel(step: 2)
 
+  # cumulative price accumulators mirrored from the smart contract, for TWAP computations
+  price0CumulativeLast: BigInt! @parallel(step: 2)
+  price1CumulativeLast: BigInt! @parallel(step: 2)
+  blockTimestampLast: Int! @parallel(step: 2)
+
   # lifetime volume stats
   volumeToken0: BigDecimal! @parallel(step: 4, type: SUM)
   volumeToken1: BigDecimal! @parallel(step: 4, type: SUM)
@@ -412,6 +663,43 @@ type Pair @entity {
   untrackedVolumeUSD: BigDecimal!  @parallel(step: 4, type: SUM)
   txCount: BigInt!  @parallel(step: 4, type: SUM)
 
+  # lifetime fee stats
+  feesToken0: BigDecimal! @parallel(step: 4, type: SUM)
+  feesToken1: BigDecimal! @parallel(step: 4, type: SUM)
+  feesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  lpFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  protocolFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+
+  # liquidity provider fees APR over the last 7 days, the current day weighted by its elapsed
+  # time, updated on swaps and syncs and recomputed from the window below when shards merge
+  apr7d: BigDecimal! @parallel(step: 4)
+
+  # apr7d window ending at aprWindowTimestamp, one slot per day summing the liquidity provider
+  # fees and reserveUSD samples of its swaps, a day using the slot of its id modulo 7. The slots
+  # move with the window instead of summing, see merge.go
+  aprWindowTimestamp: Int! @parallel(step: 4)
+  aprLpFeesUSD0: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSum0: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSamples0: BigInt! @parallel(step: 4)
+  aprLpFeesUSD1: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSum1: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSamples1: BigInt! @parallel(step: 4)
+  aprLpFeesUSD2: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSum2: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSamples2: BigInt! @parallel(step: 4)
+  aprLpFeesUSD3: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSum3: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSamples3: BigInt! @parallel(step: 4)
+  aprLpFeesUSD4: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSum4: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSamples4: BigInt! @parallel(step: 4)
+  aprLpFeesUSD5: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSum5: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSamples5: BigInt! @parallel(step: 4)
+  aprLpFeesUSD6: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSum6: BigDecimal! @parallel(step: 4)
+  aprReserveUSDSamples6: BigInt! @parallel(step: 4)
+
   # Fields used to help derived relationship
   # used to detect new exchanges
   liquidityProviderCount: BigInt! @parallel(step: 4, type: SUM)
@@ -459,6 +747,31 @@ type PairHourData @entity {
   # derived liquidity
   reserveUSD: BigDecimal!
 
+  # cumulative price accumulators as of the last update of the hour
+  price0CumulativeLast: BigInt! @parallel(step: 4)
+  price1CumulativeLast: BigInt! @parallel(step: 4)
+  blockTimestampLast: Int! @parallel(step: 4)
+
+  # price candles over the hour, see Pair token0Price/token1Price
+  token0PriceOpen: BigDecimal! @parallel(step: 4)
+  token0PriceHigh: BigDecimal! @parallel(step: 4)
+  token0PriceLow: BigDecimal! @parallel(step: 4)
+  token0PriceClose: BigDecimal! @parallel(step: 4)
+  token1PriceOpen: BigDecimal! @parallel(step: 4)
+  token1PriceHigh: BigDecimal! @parallel(step: 4)
+  token1PriceLow: BigDecimal! @parallel(step: 4)
+  token1PriceClose: BigDecimal! @parallel(step: 4)
+
+  # USD price candles of each token over the hour
+  token0PriceUSDOpen: BigDecimal! @parallel(step: 4)
+  token0PriceUSDHigh: BigDecimal! @parallel(step: 4)
+  token0PriceUSDLow: BigDecimal! @parallel(step: 4)
+  token0PriceUSDClose: BigDecimal! @parallel(step: 4)
+  token1PriceUSDOpen: BigDecimal! @parallel(step: 4)
+  token1PriceUSDHigh: BigDecimal! @parallel(step: 4)
+  token1PriceUSDLow: BigDecimal! @parallel(step: 4)
+  token1PriceUSDClose: BigDecimal! @parallel(step: 4)
+
   # volume
   volumeToken0: BigDecimal! @parallel(step: 4, type: SUM)
   volumeToken1: BigDecimal! @parallel(step: 4, type: SUM)
@@ -466,6 +779,22 @@ type PairHourData @entity {
   # volume usd
   volumeUSD: BigDecimal! @parallel(step: 4, type: SUM)
 
+  # fees
+  feesToken0: BigDecimal! @parallel(step: 4, type: SUM)
+  feesToken1: BigDecimal! @parallel(step: 4, type: SUM)
+  feesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  lpFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  protocolFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+
+  # reserveUSD sampled at each swap, averaged for the APR
+  reserveUSDSum: BigDecimal! @parallel(step: 4, type: SUM)
+  reserveUSDSamples: BigInt! @parallel(step: 4, type: SUM)
+
+  # liquidity provider fees over the average reserveUSD, annualized
+  apr: BigDecimal! @parallel(step: 4)
+  # apr compounded every hour
+  apy: BigDecimal! @parallel(step: 4)
+
   # tx count
   txCount: BigInt! @parallel(step: 4, type: SUM)
 }
@@ -497,6 +826,31 @@ type PairDayData @entity {
   # derived liquidity
   reserveUSD: BigDecimal! @parallel(step: 4)
 
+  # cumulative price accumulators as of the last update of the day
+  price0CumulativeLast: BigInt! @parallel(step: 4)
+  price1CumulativeLast: BigInt! @parallel(step: 4)
+  blockTimestampLast: Int! @parallel(step: 4)
+
+  # price candles over the day, see Pair token0Price/token1Price
+  token0PriceOpen: BigDecimal! @parallel(step: 4)
+  token0PriceHigh: BigDecimal! @parallel(step: 4)
+  token0PriceLow: BigDecimal! @parallel(step: 4)
+  token0PriceClose: BigDecimal! @parallel(step: 4)
+  token1PriceOpen: BigDecimal! @parallel(step: 4)
+  token1PriceHigh: BigDecimal! @parallel(step: 4)
+  token1PriceLow: BigDecimal! @parallel(step: 4)
+  token1PriceClose: BigDecimal! @parallel(step: 4)
+
+  # USD price candles of each token over the day
+  token0PriceUSDOpen: BigDecimal! @parallel(step: 4)
+  token0PriceUSDHigh: BigDecimal! @parallel(step: 4)
+  token0PriceUSDLow: BigDecimal! @parallel(step: 4)
+  token0PriceUSDClose: BigDecimal! @parallel(step: 4)
+  token1PriceUSDOpen: BigDecimal! @parallel(step: 4)
+  token1PriceUSDHigh: BigDecimal! @parallel(step: 4)
+  token1PriceUSDLow: BigDecimal! @parallel(step: 4)
+  token1PriceUSDClose: BigDecimal! @parallel(step: 4)
+
   # volume
   volumeToken0: BigDecimal! @parallel(step: 4, type: SUM)
   volumeToken1: BigDecimal! @parallel(step: 4, type: SUM)
@@ -504,6 +858,84 @@ type PairDayData @entity {
   # volume usd
   volumeUSD: BigDecimal!  @parallel(step: 4, type: SUM)
 
+  # fees
+  feesToken0: BigDecimal! @parallel(step: 4, type: SUM)
+  feesToken1: BigDecimal! @parallel(step: 4, type: SUM)
+  feesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  lpFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  protocolFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+
+  # reserveUSD sampled at each swap, averaged for the APR
+  reserveUSDSum: BigDecimal! @parallel(step: 4, type: SUM)
+  reserveUSDSamples: BigInt! @parallel(step: 4, type: SUM)
+
+  # liquidity provider fees over the average reserveUSD, annualized
+  apr: BigDecimal! @parallel(step: 4)
+  # apr compounded every day
+  apy: BigDecimal! @parallel(step: 4)
+
+  # tx count
+  txCount: BigInt! @parallel(step: 4, type: SUM)
+}
+
+# Pair data over a configured interval (5m, 15m, 4h, 1w, ...)
+type PairIntervalData @entity {
+  # pair id - interval - bucket id
+  id: ID!
+
+  # interval name
+  interval: String! @parallel(step: 4)
+
+  # date - bucket start timestamp
+  date: Int! @parallel(step: 4)
+
+  # pair
+  pair: Pair! @parallel(step: 4)
+
+  # reserves
+  reserve0: BigDecimal! @parallel(step: 4)
+  reserve1: BigDecimal! @parallel(step: 4)
+
+  # derived liquidity
+  reserveUSD: BigDecimal! @parallel(step: 4)
+
+  # cumulative price accumulators as of the last update of the interval
+  price0CumulativeLast: BigInt! @parallel(step: 4)
+  price1CumulativeLast: BigInt! @parallel(step: 4)
+  blockTimestampLast: Int! @parallel(step: 4)
+
+  # price candles over the interval, see PairHourData
+  token0PriceOpen: BigDecimal! @parallel(step: 4)
+  token0PriceHigh: BigDecimal! @parallel(step: 4)
+  token0PriceLow: BigDecimal! @parallel(step: 4)
+  token0PriceClose: BigDecimal! @parallel(step: 4)
+  token1PriceOpen: BigDecimal! @parallel(step: 4)
+  token1PriceHigh: BigDecimal! @parallel(step: 4)
+  token1PriceLow: BigDecimal! @parallel(step: 4)
+  token1PriceClose: BigDecimal! @parallel(step: 4)
+  token0PriceUSDOpen: BigDecimal! @parallel(step: 4)
+  token0PriceUSDHigh: BigDecimal! @parallel(step: 4)
+  token0PriceUSDLow: BigDecimal! @parallel(step: 4)
+  token0PriceUSDClose: BigDecimal! @parallel(step: 4)
+  token1PriceUSDOpen: BigDecimal! @parallel(step: 4)
+  token1PriceUSDHigh: BigDecimal! @parallel(step: 4)
+  token1PriceUSDLow: BigDecimal! @parallel(step: 4)
+  token1PriceUSDClose: BigDecimal! @parallel(step: 4)
+
+  # volume
+  volumeToken0: BigDecimal! @parallel(step: 4, type: SUM)
+  volumeToken1: BigDecimal! @parallel(step: 4, type: SUM)
+
+  # volume usd
+  volumeUSD: BigDecimal! @parallel(step: 4, type: SUM)
+
+  # fees
+  feesToken0: BigDecimal! @parallel(step: 4, type: SUM)
+  feesToken1: BigDecimal! @parallel(step: 4, type: SUM)
+  feesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  lpFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+  protocolFeesUSD: BigDecimal! @parallel(step: 4, type: SUM)
+
   # tx count
   txCount: BigInt! @parallel(step: 4, type: SUM)
 }
@@ -513,12 +945,40 @@ type LiquidityPosition @entity {
   id: ID!
   user: User! @parallel(step: 4)
   pair: Pair! @parallel(step: 4)
-  liquidityTokenBalance: BigDecimal! @parallel(step: 4)
+  # balances are summed one step before the liquidity provider counts following them
+  liquidityTokenBalance: BigDecimal! @parallel(step: 3, type: SUM)
   snapshots: [LiquidityPositionSnapshot]! @derivedFrom(field: "liquidityPosition")
+  lastSnapshot: LiquidityPositionSnapshot @parallel(step: 4)
+
+  # liquidity tokens staked in MasterChef, the wallet balance not including them
+  stakedLiquidityTokenBalance: BigDecimal! @parallel(step: 3, type: SUM)
+  totalLiquidityTokenBalance: BigDecimal! @parallel(step: 3, type: SUM)
+  stakes: [MasterChefStake!]! @derivedFrom(field: "liquidityPosition")
+
   block: Int! @parallel(step: 4)
   timestamp: Int! @parallel(step: 4)
 }
 
+# last allowance approved by a liquidity provider over its pair tokens, set by the Approval
+# events of approve and permit only, so spending it through transferFrom is not reflected
+type LPAllowance @entity {
+  # pair address - owner address - spender address
+  id: ID!
+  owner: String! @parallel(step: 4)
+  spender: String! @parallel(step: 4)
+  pair: Pair! @parallel(step: 4)
+
+  # last approved amount, not the remaining allowance: transferFrom spends it without
+  # emitting an Approval event and the Transfer event does not tell who the spender is
+  amount: BigInt! @parallel(step: 4)
+
+  # true when the approved amount is the maximum uint256 value
+  unlimited: Boolean! @parallel(step: 4)
+
+  lastUpdatedBlock: Int! @parallel(step: 4)
+  lastUpdatedTimestamp: Int! @parallel(step: 4)
+}
+
 # saved over time for return calculations, gets created and never updated
 type LiquidityPositionSnapshot @entity {
   id: ID!
@@ -533,8 +993,27 @@ type LiquidityPositionSnapshot @entity {
   reserve1: BigDecimal! @parallel(step: 4) # snapshot of pair token1 reserves
   reserveUSD: BigDecimal! @parallel(step: 4) # snapshot of pair reserves in USD
   liquidityTokenTotalSupply: BigDecimal! @parallel(step: 4, type: SUM) # snapshot of pool token supply
-  # snapshot of users pool token balance
+  # snapshot of users pool token balance, in the wallet
   liquidityTokenBalance: BigDecimal! @parallel(step: 4)
+  # snapshot of the pool tokens staked in MasterChef, returns are computed on the total balance
+  stakedLiquidityTokenBalance: BigDecimal! @parallel(step: 4)
+  totalLiquidityTokenBalance: BigDecimal! @parallel(step: 4)
+
+  # previous snapshot of the position, returns below are computed since then
+  previousSnapshot: LiquidityPositionSnapshot @parallel(step: 4)
+  # underlying amounts of the position
+  token0Amount: BigDecimal! @parallel(step: 4)
+  token1Amount: BigDecimal! @parallel(step: 4)
+  # value of the position in USD
+  valueUSD: BigDecimal! @parallel(step: 4)
+  # value in USD of holding, for the current balance, the underlying amounts of the previous snapshot
+  hodlValueUSD: BigDecimal! @parallel(step: 4)
+  # valueUSD - hodlValueUSD, impermanent loss and accrued fees combined
+  pnlUSD: BigDecimal! @parallel(step: 4)
+  # relative loss caused by the price divergence since the previous snapshot, fees excluded
+  impermanentLoss: BigDecimal! @parallel(step: 4)
+  # fees accrued in USD by the current balance since the previous snapshot
+  feesAccruedUSD: BigDecimal! @parallel(step: 4)
 }
 
 # transaction
@@ -622,6 +1101,128 @@ type Swap @entity {
 
   # derived info
   amountUSD: BigDecimal! @parallel(step: 4)
+
+  # fees taken on the input amounts
+  feesToken0: BigDecimal! @parallel(step: 4)
+  feesToken1: BigDecimal! @parallel(step: 4)
+  feesUSD: BigDecimal! @parallel(step: 4)
+  lpFeesUSD: BigDecimal! @parallel(step: 4)
+  protocolFeesUSD: BigDecimal! @parallel(step: 4)
+}
+
+# SUSHI rewards claimed from the merkle distributor
+type Claim @entity {
+  # week - claim index
+  id: ID!
+  index: BigInt! @parallel(step: 4)
+  account: String! @parallel(step: 4)
+  amount: BigDecimal! @parallel(step: 4)
+  week: BigInt! @parallel(step: 4)
+
+  block: Int! @parallel(step: 4)
+  timestamp: Int! @parallel(step: 4)
+  transaction: String! @parallel(step: 4)
+}
+
+# merkle root published by the distributor for a week
+type MerkleRoot @entity {
+  # transaction hash - log index
+  id: ID!
+  root: String! @parallel(step: 4)
+  week: BigInt! @parallel(step: 4)
+
+  block: Int! @parallel(step: 4)
+  timestamp: Int! @parallel(step: 4)
+  transaction: String! @parallel(step: 4)
+}
+
+# SUSHI supply, there is a single entity with id "1"
+type SushiSupply @entity {
+  id: ID!
+  # minted minus burned, summed one step before the day data so they snapshot merged values
+  totalSupply: BigDecimal! @parallel(step: 3, type: SUM)
+  # total supply minus the balances of the non circulating addresses of the network
+  circulatingSupply: BigDecimal! @parallel(step: 3, type: SUM)
+}
+
+# SUSHI holder
+type SushiHolder @entity {
+  # holder address
+  id: ID!
+  # summed one step before the day data so they snapshot merged values
+  balance: BigDecimal! @parallel(step: 3, type: SUM)
+  delegate: SushiDelegate @parallel(step: 4)
+  dayData: [SushiHolderDayData!]! @derivedFrom(field: "holder")
+  lastUpdatedBlock: Int! @parallel(step: 4)
+}
+
+# SUSHI supply at the end of the day, only created for days with transfers
+type SushiSupplyDayData @entity {
+  # day id
+  id: ID!
+  date: Int! @parallel(step: 4)
+  totalSupply: BigDecimal! @parallel(step: 4)
+  circulatingSupply: BigDecimal! @parallel(step: 4)
+}
+
+# SUSHI balance of a holder at the end of the day, only created for days its balance changed
+type SushiHolderDayData @entity {
+  # holder address - day id
+  id: ID!
+  date: Int! @parallel(step: 4)
+  holder: SushiHolder! @parallel(step: 4)
+  balance: BigDecimal! @parallel(step: 4)
+}
+
+# SUSHI governance delegate
+type SushiDelegate @entity {
+  # delegate address
+  id: ID!
+  votes: BigDecimal! @parallel(step: 4)
+  delegators: [SushiHolder!]! @derivedFrom(field: "delegate")
+  voteChanges: [SushiVoteChange!]! @derivedFrom(field: "delegate")
+  lastUpdatedBlock: Int! @parallel(step: 4)
+}
+
+# change of the votes of a delegate, gets created and never updated
+type SushiVoteChange @entity {
+  # transaction hash - log index
+  id: ID!
+  delegate: SushiDelegate! @parallel(step: 4)
+  previousVotes: BigDecimal! @parallel(step: 4)
+  newVotes: BigDecimal! @parallel(step: 4)
+
+  block: Int! @parallel(step: 4)
+  timestamp: Int! @parallel(step: 4)
+  transaction: String! @parallel(step: 4)
+}
+
+# MasterChef pool
+type MasterChefPool @entity {
+  # pool id
+  id: ID!
+  # pair of the staked liquidity token, unset for tokens of other exchanges
+  pair: Pair @parallel(step: 2)
+  stakes: [MasterChefStake!]! @derivedFrom(field: "pool")
+  lastUpdatedBlock: Int! @parallel(step: 4)
+}
+
+# liquidity tokens staked by a user in a MasterChef pool
+type MasterChefStake @entity {
+  # pool id - user address
+  id: ID!
+  pool: MasterChefPool! @parallel(step: 2)
+  user: User! @parallel(step: 2)
+
+  # summed two steps before the position counts, so the staked balances of the positions can
+  # be moved by exact amounts at step 3
+  amount: BigDecimal! @parallel(step: 2, type: SUM)
+
+  # position the amount is counted in, unset for tokens of other exchanges
+  pair: Pair @parallel(step: 2)
+  liquidityPosition: LiquidityPosition @parallel(step: 2)
+
+  lastUpdatedBlock: Int! @parallel(step: 4)
 }
 `,
 	Abis: map[string]string{
@@ -1166,35 +1767,30 @@ type Swap @entity {
   }
 ]
 `,
-		"Pair": `[
-  {
-    "inputs": [],
-    "stateMutability": "nonpayable",
-    "type": "constructor"
-  },
+		"MasterChef": `[
   {
     "anonymous": false,
     "inputs": [
       {
         "indexed": true,
         "internalType": "address",
-        "name": "owner",
+        "name": "user",
         "type": "address"
       },
       {
         "indexed": true,
-        "internalType": "address",
-        "name": "spender",
-        "type": "address"
+        "internalType": "uint256",
+        "name": "pid",
+        "type": "uint256"
       },
       {
         "indexed": false,
         "internalType": "uint256",
-        "name": "value",
+        "name": "amount",
         "type": "uint256"
       }
     ],
-    "name": "Approval",
+    "name": "Deposit",
     "type": "event"
   },
   {
@@ -1203,29 +1799,23 @@ type Swap @entity {
       {
         "indexed": true,
         "internalType": "address",
-        "name": "sender",
+        "name": "user",
         "type": "address"
       },
       {
-        "indexed": false,
+        "indexed": true,
         "internalType": "uint256",
-        "name": "amount0",
+        "name": "pid",
         "type": "uint256"
       },
       {
         "indexed": false,
         "internalType": "uint256",
-        "name": "amount1",
+        "name": "amount",
         "type": "uint256"
-      },
-      {
-        "indexed": true,
-        "internalType": "address",
-        "name": "to",
-        "type": "address"
       }
     ],
-    "name": "Burn",
+    "name": "EmergencyWithdraw",
     "type": "event"
   },
   {
@@ -1234,48 +1824,262 @@ type Swap @entity {
       {
         "indexed": true,
         "internalType": "address",
-        "name": "sender",
+        "name": "user",
         "type": "address"
       },
       {
-        "indexed": false,
+        "indexed": true,
         "internalType": "uint256",
-        "name": "amount0",
+        "name": "pid",
         "type": "uint256"
       },
       {
         "indexed": false,
         "internalType": "uint256",
-        "name": "amount1",
+        "name": "amount",
         "type": "uint256"
       }
     ],
-    "name": "Mint",
+    "name": "Withdraw",
     "type": "event"
   },
   {
-    "anonymous": false,
     "inputs": [
       {
-        "indexed": true,
-        "internalType": "address",
-        "name": "sender",
-        "type": "address"
-      },
-      {
-        "indexed": false,
         "internalType": "uint256",
-        "name": "amount0In",
+        "name": "_pid",
         "type": "uint256"
       },
       {
-        "indexed": false,
         "internalType": "uint256",
-        "name": "amount1In",
+        "name": "_amount",
         "type": "uint256"
-      },
+      }
+    ],
+    "name": "deposit",
+    "outputs": [],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
       {
-        "indexed": false,
+        "internalType": "uint256",
+        "name": "_pid",
+        "type": "uint256"
+      }
+    ],
+    "name": "emergencyWithdraw",
+    "outputs": [],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint256",
+        "name": "",
+        "type": "uint256"
+      }
+    ],
+    "name": "poolInfo",
+    "outputs": [
+      {
+        "internalType": "contract IERC20",
+        "name": "lpToken",
+        "type": "address"
+      },
+      {
+        "internalType": "uint256",
+        "name": "allocPoint",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "lastRewardBlock",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "accSushiPerShare",
+        "type": "uint256"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "poolLength",
+    "outputs": [
+      {
+        "internalType": "uint256",
+        "name": "",
+        "type": "uint256"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint256",
+        "name": "",
+        "type": "uint256"
+      },
+      {
+        "internalType": "address",
+        "name": "",
+        "type": "address"
+      }
+    ],
+    "name": "userInfo",
+    "outputs": [
+      {
+        "internalType": "uint256",
+        "name": "amount",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "rewardDebt",
+        "type": "uint256"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "internalType": "uint256",
+        "name": "_pid",
+        "type": "uint256"
+      },
+      {
+        "internalType": "uint256",
+        "name": "_amount",
+        "type": "uint256"
+      }
+    ],
+    "name": "withdraw",
+    "outputs": [],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  }
+]
+`,
+		"Pair": `[
+  {
+    "inputs": [],
+    "stateMutability": "nonpayable",
+    "type": "constructor"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": true,
+        "internalType": "address",
+        "name": "owner",
+        "type": "address"
+      },
+      {
+        "indexed": true,
+        "internalType": "address",
+        "name": "spender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "value",
+        "type": "uint256"
+      }
+    ],
+    "name": "Approval",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": true,
+        "internalType": "address",
+        "name": "sender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "amount0",
+        "type": "uint256"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "amount1",
+        "type": "uint256"
+      },
+      {
+        "indexed": true,
+        "internalType": "address",
+        "name": "to",
+        "type": "address"
+      }
+    ],
+    "name": "Burn",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": true,
+        "internalType": "address",
+        "name": "sender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "amount0",
+        "type": "uint256"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "amount1",
+        "type": "uint256"
+      }
+    ],
+    "name": "Mint",
+    "type": "event"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": true,
+        "internalType": "address",
+        "name": "sender",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "amount0In",
+        "type": "uint256"
+      },
+      {
+        "indexed": false,
+        "internalType": "uint256",
+        "name": "amount1In",
+        "type": "uint256"
+      },
+      {
+        "indexed": false,
         "internalType": "uint256",
         "name": "amount0Out",
         "type": "uint256"
@@ -1825,6 +2629,305 @@ type Swap @entity {
   }
 ]
 `,
+		"SushiDistributor": `[
+    {
+        "inputs":[
+            {
+                "internalType":"address",
+                "name":"token_",
+                "type":"address"
+            },
+            {
+                "internalType":"bytes32",
+                "name":"merkleRoot_",
+                "type":"bytes32"
+            }
+        ],
+        "stateMutability":"nonpayable",
+        "type":"constructor"
+    },
+    {
+        "anonymous":false,
+        "inputs":[
+            {
+                "indexed":false,
+                "internalType":"uint256",
+                "name":"index",
+                "type":"uint256"
+            },
+            {
+                "indexed":false,
+                "internalType":"uint256",
+                "name":"amount",
+                "type":"uint256"
+            },
+            {
+                "indexed":true,
+                "internalType":"address",
+                "name":"account",
+                "type":"address"
+            },
+            {
+                "indexed":true,
+                "internalType":"uint256",
+                "name":"week",
+                "type":"uint256"
+            }
+        ],
+        "name":"Claimed",
+        "type":"event"
+    },
+    {
+        "anonymous":false,
+        "inputs":[
+            {
+                "indexed":true,
+                "internalType":"bytes32",
+                "name":"merkleRoot",
+                "type":"bytes32"
+            },
+            {
+                "indexed":true,
+                "internalType":"uint32",
+                "name":"week",
+                "type":"uint32"
+            }
+        ],
+        "name":"MerkleRootUpdated",
+        "type":"event"
+    },
+    {
+        "anonymous":false,
+        "inputs":[
+            {
+                "indexed":true,
+                "internalType":"address",
+                "name":"previousOwner",
+                "type":"address"
+            },
+            {
+                "indexed":true,
+                "internalType":"address",
+                "name":"newOwner",
+                "type":"address"
+            }
+        ],
+        "name":"OwnershipTransferred",
+        "type":"event"
+    },
+    {
+        "inputs":[
+            {
+                "internalType":"uint256",
+                "name":"index",
+                "type":"uint256"
+            },
+            {
+                "internalType":"address",
+                "name":"account",
+                "type":"address"
+            },
+            {
+                "internalType":"uint256",
+                "name":"amount",
+                "type":"uint256"
+            },
+            {
+                "internalType":"bytes32[]",
+                "name":"merkleProof",
+                "type":"bytes32[]"
+            }
+        ],
+        "name":"claim",
+        "outputs":[
+            
+        ],
+        "stateMutability":"nonpayable",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            
+        ],
+        "name":"claimOwnership",
+        "outputs":[
+            
+        ],
+        "stateMutability":"nonpayable",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            
+        ],
+        "name":"freeze",
+        "outputs":[
+            
+        ],
+        "stateMutability":"nonpayable",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            
+        ],
+        "name":"frozen",
+        "outputs":[
+            {
+                "internalType":"bool",
+                "name":"",
+                "type":"bool"
+            }
+        ],
+        "stateMutability":"view",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            {
+                "internalType":"uint256",
+                "name":"index",
+                "type":"uint256"
+            }
+        ],
+        "name":"isClaimed",
+        "outputs":[
+            {
+                "internalType":"bool",
+                "name":"",
+                "type":"bool"
+            }
+        ],
+        "stateMutability":"view",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            
+        ],
+        "name":"merkleRoot",
+        "outputs":[
+            {
+                "internalType":"bytes32",
+                "name":"",
+                "type":"bytes32"
+            }
+        ],
+        "stateMutability":"view",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            
+        ],
+        "name":"owner",
+        "outputs":[
+            {
+                "internalType":"address",
+                "name":"",
+                "type":"address"
+            }
+        ],
+        "stateMutability":"view",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            
+        ],
+        "name":"pendingOwner",
+        "outputs":[
+            {
+                "internalType":"address",
+                "name":"",
+                "type":"address"
+            }
+        ],
+        "stateMutability":"view",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            
+        ],
+        "name":"token",
+        "outputs":[
+            {
+                "internalType":"address",
+                "name":"",
+                "type":"address"
+            }
+        ],
+        "stateMutability":"view",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            {
+                "internalType":"address",
+                "name":"newOwner",
+                "type":"address"
+            },
+            {
+                "internalType":"bool",
+                "name":"direct",
+                "type":"bool"
+            },
+            {
+                "internalType":"bool",
+                "name":"renounce",
+                "type":"bool"
+            }
+        ],
+        "name":"transferOwnership",
+        "outputs":[
+            
+        ],
+        "stateMutability":"nonpayable",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            
+        ],
+        "name":"unfreeze",
+        "outputs":[
+            
+        ],
+        "stateMutability":"nonpayable",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            {
+                "internalType":"bytes32",
+                "name":"_merkleRoot",
+                "type":"bytes32"
+            }
+        ],
+        "name":"updateMerkleRoot",
+        "outputs":[
+            
+        ],
+        "stateMutability":"nonpayable",
+        "type":"function"
+    },
+    {
+        "inputs":[
+            
+        ],
+        "name":"week",
+        "outputs":[
+            {
+                "internalType":"uint32",
+                "name":"",
+                "type":"uint32"
+            }
+        ],
+        "stateMutability":"view",
+        "type":"function"
+    }
+]`,
 		"SushiToken": `[
   {
     "anonymous": false,
@@ -2455,6 +3558,50 @@ type Swap @entity {
 			el := new.(*Bundle)
 			el.Merge(step, c)
 			return el
+		case interface {
+			Merge(step int, new *EthPriceHourData)
+		}:
+			var c *EthPriceHourData
+			if cached == nil {
+				return new.(*EthPriceHourData)
+			}
+			c = cached.(*EthPriceHourData)
+			el := new.(*EthPriceHourData)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *EthPriceDayData)
+		}:
+			var c *EthPriceDayData
+			if cached == nil {
+				return new.(*EthPriceDayData)
+			}
+			c = cached.(*EthPriceDayData)
+			el := new.(*EthPriceDayData)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *EthPriceSourceData)
+		}:
+			var c *EthPriceSourceData
+			if cached == nil {
+				return new.(*EthPriceSourceData)
+			}
+			c = cached.(*EthPriceSourceData)
+			el := new.(*EthPriceSourceData)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *PriceAnomaly)
+		}:
+			var c *PriceAnomaly
+			if cached == nil {
+				return new.(*PriceAnomaly)
+			}
+			c = cached.(*PriceAnomaly)
+			el := new.(*PriceAnomaly)
+			el.Merge(step, c)
+			return el
 		case interface {
 			Merge(step int, new *Factory)
 		}:
@@ -2521,6 +3668,17 @@ type Swap @entity {
 			el := new.(*TokenDayData)
 			el.Merge(step, c)
 			return el
+		case interface {
+			Merge(step int, new *TokenIntervalData)
+		}:
+			var c *TokenIntervalData
+			if cached == nil {
+				return new.(*TokenIntervalData)
+			}
+			c = cached.(*TokenIntervalData)
+			el := new.(*TokenIntervalData)
+			el.Merge(step, c)
+			return el
 		case interface {
 			Merge(step int, new *Pair)
 		}:
@@ -2554,6 +3712,17 @@ type Swap @entity {
 			el := new.(*PairDayData)
 			el.Merge(step, c)
 			return el
+		case interface {
+			Merge(step int, new *PairIntervalData)
+		}:
+			var c *PairIntervalData
+			if cached == nil {
+				return new.(*PairIntervalData)
+			}
+			c = cached.(*PairIntervalData)
+			el := new.(*PairIntervalData)
+			el.Merge(step, c)
+			return el
 		case interface {
 			Merge(step int, new *LiquidityPosition)
 		}:
@@ -2565,6 +3734,17 @@ type Swap @entity {
 			el := new.(*LiquidityPosition)
 			el.Merge(step, c)
 			return el
+		case interface {
+			Merge(step int, new *LPAllowance)
+		}:
+			var c *LPAllowance
+			if cached == nil {
+				return new.(*LPAllowance)
+			}
+			c = cached.(*LPAllowance)
+			el := new.(*LPAllowance)
+			el.Merge(step, c)
+			return el
 		case interface {
 			Merge(step int, new *LiquidityPositionSnapshot)
 		}:
@@ -2620,28 +3800,146 @@ type Swap @entity {
 			el := new.(*Swap)
 			el.Merge(step, c)
 			return el
-		case *DynamicDataSourceXXX:
-			return new
-		}
-		panic("unsupported merge type")
-	},
-}
-
-type Subgraph struct {
-	subgraph.Base
-
-	CurrentBlockDynamicDataSources map[string]*DynamicDataSourceXXX
+		case interface {
+			Merge(step int, new *Claim)
+		}:
+			var c *Claim
+			if cached == nil {
+				return new.(*Claim)
+			}
+			c = cached.(*Claim)
+			el := new.(*Claim)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *MerkleRoot)
+		}:
+			var c *MerkleRoot
+			if cached == nil {
+				return new.(*MerkleRoot)
+			}
+			c = cached.(*MerkleRoot)
+			el := new.(*MerkleRoot)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *SushiSupply)
+		}:
+			var c *SushiSupply
+			if cached == nil {
+				return new.(*SushiSupply)
+			}
+			c = cached.(*SushiSupply)
+			el := new.(*SushiSupply)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *SushiHolder)
+		}:
+			var c *SushiHolder
+			if cached == nil {
+				return new.(*SushiHolder)
+			}
+			c = cached.(*SushiHolder)
+			el := new.(*SushiHolder)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *SushiSupplyDayData)
+		}:
+			var c *SushiSupplyDayData
+			if cached == nil {
+				return new.(*SushiSupplyDayData)
+			}
+			c = cached.(*SushiSupplyDayData)
+			el := new.(*SushiSupplyDayData)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *SushiHolderDayData)
+		}:
+			var c *SushiHolderDayData
+			if cached == nil {
+				return new.(*SushiHolderDayData)
+			}
+			c = cached.(*SushiHolderDayData)
+			el := new.(*SushiHolderDayData)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *SushiDelegate)
+		}:
+			var c *SushiDelegate
+			if cached == nil {
+				return new.(*SushiDelegate)
+			}
+			c = cached.(*SushiDelegate)
+			el := new.(*SushiDelegate)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *SushiVoteChange)
+		}:
+			var c *SushiVoteChange
+			if cached == nil {
+				return new.(*SushiVoteChange)
+			}
+			c = cached.(*SushiVoteChange)
+			el := new.(*SushiVoteChange)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *MasterChefPool)
+		}:
+			var c *MasterChefPool
+			if cached == nil {
+				return new.(*MasterChefPool)
+			}
+			c = cached.(*MasterChefPool)
+			el := new.(*MasterChefPool)
+			el.Merge(step, c)
+			return el
+		case interface {
+			Merge(step int, new *MasterChefStake)
+		}:
+			var c *MasterChefStake
+			if cached == nil {
+				return new.(*MasterChefStake)
+			}
+			c = cached.(*MasterChefStake)
+			el := new.(*MasterChefStake)
+			el.Merge(step, c)
+			return el
+		case *DynamicDataSourceXXX:
+			return new
+		}
+		panic("unsupported merge type")
+	},
+}
+
+type Subgraph struct {
+	subgraph.Base
+
+	CurrentBlockDynamicDataSources map[string]*DynamicDataSourceXXX
 	DynamicDataSources             map[string]*DynamicDataSourceXXX
 }
 
 // User
 type User struct {
 	entity.Base
+	UsdSwapped          entity.Float `db:"usd_swapped" csv:"usd_swapped"`
+	SwapCount           entity.Int   `db:"swap_count" csv:"swap_count"`
+	ActivePositionCount entity.Int   `db:"active_position_count" csv:"active_position_count"`
+	FirstSeenBlock      int64        `db:"first_seen_block" csv:"first_seen_block"`
+	LastActiveBlock     int64        `db:"last_active_block" csv:"last_active_block"`
 }
 
 func NewUser(id string) *User {
 	return &User{
-		Base: entity.NewBase(id),
+		Base:                entity.NewBase(id),
+		UsdSwapped:          FL(0),
+		SwapCount:           IL(0),
+		ActivePositionCount: IL(0),
 	}
 }
 
@@ -2649,18 +3947,31 @@ func (_ *User) SkipDBLookup() bool {
 	return false
 }
 func (next *User) Merge(step int, cached *User) {
+	if step == 5 {
+		next.UsdSwapped = entity.FloatAdd(next.UsdSwapped, cached.UsdSwapped)
+		next.SwapCount = entity.IntAdd(next.SwapCount, cached.SwapCount)
+		next.ActivePositionCount = entity.IntAdd(next.ActivePositionCount, cached.ActivePositionCount)
+		if next.MutatedOnStep != 4 {
+			next.FirstSeenBlock = cached.FirstSeenBlock
+			next.LastActiveBlock = cached.LastActiveBlock
+		}
+	}
 }
 
 // Bundle
 type Bundle struct {
 	entity.Base
-	EthPrice entity.Float `db:"eth_price" csv:"eth_price"`
+	EthPrice             entity.Float `db:"eth_price" csv:"eth_price"`
+	EthPriceBlock        int64        `db:"eth_price_block" csv:"eth_price_block"`
+	PendingEthPrice      entity.Float `db:"pending_eth_price" csv:"pending_eth_price"`
+	PendingEthPriceBlock int64        `db:"pending_eth_price_block" csv:"pending_eth_price_block"`
 }
 
 func NewBundle(id string) *Bundle {
 	return &Bundle{
-		Base:     entity.NewBase(id),
-		EthPrice: FL(0),
+		Base:            entity.NewBase(id),
+		EthPrice:        FL(0),
+		PendingEthPrice: FL(0),
 	}
 }
 
@@ -2671,6 +3982,152 @@ func (next *Bundle) Merge(step int, cached *Bundle) {
 	if step == 5 {
 		if next.MutatedOnStep != 4 {
 			next.EthPrice = cached.EthPrice
+			next.EthPriceBlock = cached.EthPriceBlock
+			next.PendingEthPrice = cached.PendingEthPrice
+			next.PendingEthPriceBlock = cached.PendingEthPriceBlock
+		}
+	}
+}
+
+// EthPriceHourData
+type EthPriceHourData struct {
+	entity.Base
+	Date  int64        `db:"date" csv:"date"`
+	Open  entity.Float `db:"open" csv:"open"`
+	High  entity.Float `db:"high" csv:"high"`
+	Low   entity.Float `db:"low" csv:"low"`
+	Close entity.Float `db:"close" csv:"close"`
+}
+
+func NewEthPriceHourData(id string) *EthPriceHourData {
+	return &EthPriceHourData{
+		Base:  entity.NewBase(id),
+		Open:  FL(0),
+		High:  FL(0),
+		Low:   FL(0),
+		Close: FL(0),
+	}
+}
+
+func (_ *EthPriceHourData) SkipDBLookup() bool {
+	return false
+}
+func (next *EthPriceHourData) Merge(step int, cached *EthPriceHourData) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Date = cached.Date
+			next.Open = cached.Open
+			next.High = cached.High
+			next.Low = cached.Low
+			next.Close = cached.Close
+		}
+	}
+}
+
+// EthPriceDayData
+type EthPriceDayData struct {
+	entity.Base
+	Date  int64        `db:"date" csv:"date"`
+	Open  entity.Float `db:"open" csv:"open"`
+	High  entity.Float `db:"high" csv:"high"`
+	Low   entity.Float `db:"low" csv:"low"`
+	Close entity.Float `db:"close" csv:"close"`
+}
+
+func NewEthPriceDayData(id string) *EthPriceDayData {
+	return &EthPriceDayData{
+		Base:  entity.NewBase(id),
+		Open:  FL(0),
+		High:  FL(0),
+		Low:   FL(0),
+		Close: FL(0),
+	}
+}
+
+func (_ *EthPriceDayData) SkipDBLookup() bool {
+	return false
+}
+func (next *EthPriceDayData) Merge(step int, cached *EthPriceDayData) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Date = cached.Date
+			next.Open = cached.Open
+			next.High = cached.High
+			next.Low = cached.Low
+			next.Close = cached.Close
+		}
+	}
+}
+
+// EthPriceSourceData
+type EthPriceSourceData struct {
+	entity.Base
+	HourData     *string      `db:"hour_data,nullable" csv:"hour_data"`
+	DayData      *string      `db:"day_data,nullable" csv:"day_data"`
+	Pair         string       `db:"pair" csv:"pair"`
+	Price        entity.Float `db:"price" csv:"price"`
+	LiquidityETH entity.Float `db:"liquidity_eth" csv:"liquidity_eth"`
+	Used         entity.Bool  `db:"used" csv:"used"`
+}
+
+func NewEthPriceSourceData(id string) *EthPriceSourceData {
+	return &EthPriceSourceData{
+		Base:         entity.NewBase(id),
+		Price:        FL(0),
+		LiquidityETH: FL(0),
+	}
+}
+
+func (_ *EthPriceSourceData) SkipDBLookup() bool {
+	return false
+}
+func (next *EthPriceSourceData) Merge(step int, cached *EthPriceSourceData) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.HourData = cached.HourData
+			next.DayData = cached.DayData
+			next.Pair = cached.Pair
+			next.Price = cached.Price
+			next.LiquidityETH = cached.LiquidityETH
+			next.Used = cached.Used
+		}
+	}
+}
+
+// PriceAnomaly
+type PriceAnomaly struct {
+	entity.Base
+	Block       int64        `db:"block" csv:"block"`
+	Timestamp   entity.Int   `db:"timestamp" csv:"timestamp"`
+	Transaction string       `db:"transaction" csv:"transaction"`
+	Pair        string       `db:"pair" csv:"pair"`
+	OldPrice    entity.Float `db:"old_price" csv:"old_price"`
+	NewPrice    entity.Float `db:"new_price" csv:"new_price"`
+	Reason      string       `db:"reason" csv:"reason"`
+}
+
+func NewPriceAnomaly(id string) *PriceAnomaly {
+	return &PriceAnomaly{
+		Base:      entity.NewBase(id),
+		Timestamp: IL(0),
+		OldPrice:  FL(0),
+		NewPrice:  FL(0),
+	}
+}
+
+func (_ *PriceAnomaly) SkipDBLookup() bool {
+	return false
+}
+func (next *PriceAnomaly) Merge(step int, cached *PriceAnomaly) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Block = cached.Block
+			next.Timestamp = cached.Timestamp
+			next.Transaction = cached.Transaction
+			next.Pair = cached.Pair
+			next.OldPrice = cached.OldPrice
+			next.NewPrice = cached.NewPrice
+			next.Reason = cached.Reason
 		}
 	}
 }
@@ -2682,6 +4139,9 @@ type Factory struct {
 	VolumeUSD          entity.Float `db:"volume_usd" csv:"volume_usd"`
 	VolumeETH          entity.Float `db:"volume_eth" csv:"volume_eth"`
 	UntrackedVolumeUSD entity.Float `db:"untracked_volume_usd" csv:"untracked_volume_usd"`
+	FeesUSD            entity.Float `db:"fees_usd" csv:"fees_usd"`
+	LpFeesUSD          entity.Float `db:"lp_fees_usd" csv:"lp_fees_usd"`
+	ProtocolFeesUSD    entity.Float `db:"protocol_fees_usd" csv:"protocol_fees_usd"`
 	LiquidityUSD       entity.Float `db:"liquidity_usd" csv:"liquidity_usd"`
 	LiquidityETH       entity.Float `db:"liquidity_eth" csv:"liquidity_eth"`
 	TxCount            entity.Int   `db:"tx_count" csv:"tx_count"`
@@ -2696,6 +4156,9 @@ func NewFactory(id string) *Factory {
 		VolumeUSD:          FL(0),
 		VolumeETH:          FL(0),
 		UntrackedVolumeUSD: FL(0),
+		FeesUSD:            FL(0),
+		LpFeesUSD:          FL(0),
+		ProtocolFeesUSD:    FL(0),
 		LiquidityUSD:       FL(0),
 		LiquidityETH:       FL(0),
 		TxCount:            IL(0),
@@ -2717,6 +4180,9 @@ func (next *Factory) Merge(step int, cached *Factory) {
 		next.VolumeUSD = entity.FloatAdd(next.VolumeUSD, cached.VolumeUSD)
 		next.VolumeETH = entity.FloatAdd(next.VolumeETH, cached.VolumeETH)
 		next.UntrackedVolumeUSD = entity.FloatAdd(next.UntrackedVolumeUSD, cached.UntrackedVolumeUSD)
+		next.FeesUSD = entity.FloatAdd(next.FeesUSD, cached.FeesUSD)
+		next.LpFeesUSD = entity.FloatAdd(next.LpFeesUSD, cached.LpFeesUSD)
+		next.ProtocolFeesUSD = entity.FloatAdd(next.ProtocolFeesUSD, cached.ProtocolFeesUSD)
 		next.TxCount = entity.IntAdd(next.TxCount, cached.TxCount)
 		next.TokenCount = entity.IntAdd(next.TokenCount, cached.TokenCount)
 		next.UserCount = entity.IntAdd(next.UserCount, cached.UserCount)
@@ -2777,6 +4243,9 @@ type DayData struct {
 	VolumeETH       entity.Float `db:"volume_eth" csv:"volume_eth"`
 	VolumeUSD       entity.Float `db:"volume_usd" csv:"volume_usd"`
 	UntrackedVolume entity.Float `db:"untracked_volume" csv:"untracked_volume"`
+	FeesUSD         entity.Float `db:"fees_usd" csv:"fees_usd"`
+	LpFeesUSD       entity.Float `db:"lp_fees_usd" csv:"lp_fees_usd"`
+	ProtocolFeesUSD entity.Float `db:"protocol_fees_usd" csv:"protocol_fees_usd"`
 	LiquidityETH    entity.Float `db:"liquidity_eth" csv:"liquidity_eth"`
 	LiquidityUSD    entity.Float `db:"liquidity_usd" csv:"liquidity_usd"`
 	TxCount         entity.Int   `db:"tx_count" csv:"tx_count"`
@@ -2788,6 +4257,9 @@ func NewDayData(id string) *DayData {
 		VolumeETH:       FL(0),
 		VolumeUSD:       FL(0),
 		UntrackedVolume: FL(0),
+		FeesUSD:         FL(0),
+		LpFeesUSD:       FL(0),
+		ProtocolFeesUSD: FL(0),
 		LiquidityETH:    FL(0),
 		LiquidityUSD:    FL(0),
 		TxCount:         IL(0),
@@ -2802,6 +4274,9 @@ func (next *DayData) Merge(step int, cached *DayData) {
 		next.VolumeETH = entity.FloatAdd(next.VolumeETH, cached.VolumeETH)
 		next.VolumeUSD = entity.FloatAdd(next.VolumeUSD, cached.VolumeUSD)
 		next.UntrackedVolume = entity.FloatAdd(next.UntrackedVolume, cached.UntrackedVolume)
+		next.FeesUSD = entity.FloatAdd(next.FeesUSD, cached.FeesUSD)
+		next.LpFeesUSD = entity.FloatAdd(next.LpFeesUSD, cached.LpFeesUSD)
+		next.ProtocolFeesUSD = entity.FloatAdd(next.ProtocolFeesUSD, cached.ProtocolFeesUSD)
 		next.TxCount = entity.IntAdd(next.TxCount, cached.TxCount)
 		if next.MutatedOnStep != 4 {
 			next.Date = cached.Date
@@ -2818,6 +4293,7 @@ type Token struct {
 	Symbol             string                  `db:"symbol" csv:"symbol"`
 	Name               string                  `db:"name" csv:"name"`
 	Decimals           entity.Int              `db:"decimals" csv:"decimals"`
+	DecimalsValid      entity.Bool             `db:"decimals_valid" csv:"decimals_valid"`
 	TotalSupply        entity.Int              `db:"total_supply" csv:"total_supply"`
 	Volume             entity.Float            `db:"volume" csv:"volume"`
 	VolumeUSD          entity.Float            `db:"volume_usd" csv:"volume_usd"`
@@ -2825,6 +4301,8 @@ type Token struct {
 	TxCount            entity.Int              `db:"tx_count" csv:"tx_count"`
 	Liquidity          entity.Float            `db:"liquidity" csv:"liquidity"`
 	DerivedETH         entity.Float            `db:"derived_eth" csv:"derived_eth"`
+	DerivedETHRoute    entity.LocalStringArray `db:"derived_eth_route" csv:"derived_eth_route"`
+	DerivedETHHops     int64                   `db:"derived_eth_hops" csv:"derived_eth_hops"`
 	WhitelistPairs     entity.LocalStringArray `db:"whitelist_pairs" csv:"whitelist_pairs"`
 }
 
@@ -2851,18 +4329,21 @@ func (next *Token) Merge(step int, cached *Token) {
 			next.Symbol = cached.Symbol
 			next.Name = cached.Name
 			next.Decimals = cached.Decimals
+			next.DecimalsValid = cached.DecimalsValid
 			next.WhitelistPairs = cached.WhitelistPairs
 		}
 	}
 	if step == 5 {
-		next.TotalSupply = entity.IntAdd(next.TotalSupply, cached.TotalSupply)
 		next.Volume = entity.FloatAdd(next.Volume, cached.Volume)
 		next.VolumeUSD = entity.FloatAdd(next.VolumeUSD, cached.VolumeUSD)
 		next.UntrackedVolumeUSD = entity.FloatAdd(next.UntrackedVolumeUSD, cached.UntrackedVolumeUSD)
 		next.TxCount = entity.IntAdd(next.TxCount, cached.TxCount)
 		next.Liquidity = entity.FloatAdd(next.Liquidity, cached.Liquidity)
 		if next.MutatedOnStep != 4 {
+			next.TotalSupply = cached.TotalSupply
 			next.DerivedETH = cached.DerivedETH
+			next.DerivedETHRoute = cached.DerivedETHRoute
+			next.DerivedETHHops = cached.DerivedETHHops
 		}
 	}
 }
@@ -2929,6 +4410,8 @@ type TokenDayData struct {
 	LiquidityETH entity.Float `db:"liquidity_eth" csv:"liquidity_eth"`
 	LiquidityUSD entity.Float `db:"liquidity_usd" csv:"liquidity_usd"`
 	PriceUSD     entity.Float `db:"price_usd" csv:"price_usd"`
+	TotalSupply  entity.Int   `db:"total_supply" csv:"total_supply"`
+	MarketCapUSD entity.Float `db:"market_cap_usd" csv:"market_cap_usd"`
 }
 
 func NewTokenDayData(id string) *TokenDayData {
@@ -2942,6 +4425,8 @@ func NewTokenDayData(id string) *TokenDayData {
 		LiquidityETH: FL(0),
 		LiquidityUSD: FL(0),
 		PriceUSD:     FL(0),
+		TotalSupply:  IL(0),
+		MarketCapUSD: FL(0),
 	}
 }
 
@@ -2961,6 +4446,59 @@ func (next *TokenDayData) Merge(step int, cached *TokenDayData) {
 			next.LiquidityETH = cached.LiquidityETH
 			next.LiquidityUSD = cached.LiquidityUSD
 			next.PriceUSD = cached.PriceUSD
+			next.TotalSupply = cached.TotalSupply
+			next.MarketCapUSD = cached.MarketCapUSD
+		}
+	}
+}
+
+// TokenIntervalData
+type TokenIntervalData struct {
+	entity.Base
+	Interval     string       `db:"interval" csv:"interval"`
+	Date         int64        `db:"date" csv:"date"`
+	Token        string       `db:"token" csv:"token"`
+	Volume       entity.Float `db:"volume" csv:"volume"`
+	VolumeETH    entity.Float `db:"volume_eth" csv:"volume_eth"`
+	VolumeUSD    entity.Float `db:"volume_usd" csv:"volume_usd"`
+	TxCount      entity.Int   `db:"tx_count" csv:"tx_count"`
+	Liquidity    entity.Float `db:"liquidity" csv:"liquidity"`
+	LiquidityETH entity.Float `db:"liquidity_eth" csv:"liquidity_eth"`
+	LiquidityUSD entity.Float `db:"liquidity_usd" csv:"liquidity_usd"`
+	PriceUSD     entity.Float `db:"price_usd" csv:"price_usd"`
+}
+
+func NewTokenIntervalData(id string) *TokenIntervalData {
+	return &TokenIntervalData{
+		Base:         entity.NewBase(id),
+		Volume:       FL(0),
+		VolumeETH:    FL(0),
+		VolumeUSD:    FL(0),
+		TxCount:      IL(0),
+		Liquidity:    FL(0),
+		LiquidityETH: FL(0),
+		LiquidityUSD: FL(0),
+		PriceUSD:     FL(0),
+	}
+}
+
+func (_ *TokenIntervalData) SkipDBLookup() bool {
+	return false
+}
+func (next *TokenIntervalData) Merge(step int, cached *TokenIntervalData) {
+	if step == 5 {
+		next.Volume = entity.FloatAdd(next.Volume, cached.Volume)
+		next.VolumeETH = entity.FloatAdd(next.VolumeETH, cached.VolumeETH)
+		next.VolumeUSD = entity.FloatAdd(next.VolumeUSD, cached.VolumeUSD)
+		next.TxCount = entity.IntAdd(next.TxCount, cached.TxCount)
+		if next.MutatedOnStep != 4 {
+			next.Interval = cached.Interval
+			next.Date = cached.Date
+			next.Token = cached.Token
+			next.Liquidity = cached.Liquidity
+			next.LiquidityETH = cached.LiquidityETH
+			next.LiquidityUSD = cached.LiquidityUSD
+			next.PriceUSD = cached.PriceUSD
 		}
 	}
 }
@@ -2980,11 +4518,42 @@ type Pair struct {
 	TrackedReserveETH      entity.Float `db:"tracked_reserve_eth" csv:"tracked_reserve_eth"`
 	Token0Price            entity.Float `db:"token_0_price" csv:"token_0_price"`
 	Token1Price            entity.Float `db:"token_1_price" csv:"token_1_price"`
+	Price0CumulativeLast   entity.Int   `db:"price_0_cumulative_last" csv:"price_0_cumulative_last"`
+	Price1CumulativeLast   entity.Int   `db:"price_1_cumulative_last" csv:"price_1_cumulative_last"`
+	BlockTimestampLast     int64        `db:"block_timestamp_last" csv:"block_timestamp_last"`
 	VolumeToken0           entity.Float `db:"volume_token_0" csv:"volume_token_0"`
 	VolumeToken1           entity.Float `db:"volume_token_1" csv:"volume_token_1"`
 	VolumeUSD              entity.Float `db:"volume_usd" csv:"volume_usd"`
 	UntrackedVolumeUSD     entity.Float `db:"untracked_volume_usd" csv:"untracked_volume_usd"`
 	TxCount                entity.Int   `db:"tx_count" csv:"tx_count"`
+	FeesToken0             entity.Float `db:"fees_token_0" csv:"fees_token_0"`
+	FeesToken1             entity.Float `db:"fees_token_1" csv:"fees_token_1"`
+	FeesUSD                entity.Float `db:"fees_usd" csv:"fees_usd"`
+	LpFeesUSD              entity.Float `db:"lp_fees_usd" csv:"lp_fees_usd"`
+	ProtocolFeesUSD        entity.Float `db:"protocol_fees_usd" csv:"protocol_fees_usd"`
+	Apr7D                  entity.Float `db:"apr_7_d" csv:"apr_7_d"`
+	AprWindowTimestamp     int64        `db:"apr_window_timestamp" csv:"apr_window_timestamp"`
+	AprLpFeesUSD0          entity.Float `db:"apr_lp_fees_usd_0" csv:"apr_lp_fees_usd_0"`
+	AprReserveUSDSum0      entity.Float `db:"apr_reserve_usd_sum_0" csv:"apr_reserve_usd_sum_0"`
+	AprReserveUSDSamples0  entity.Int   `db:"apr_reserve_usd_samples_0" csv:"apr_reserve_usd_samples_0"`
+	AprLpFeesUSD1          entity.Float `db:"apr_lp_fees_usd_1" csv:"apr_lp_fees_usd_1"`
+	AprReserveUSDSum1      entity.Float `db:"apr_reserve_usd_sum_1" csv:"apr_reserve_usd_sum_1"`
+	AprReserveUSDSamples1  entity.Int   `db:"apr_reserve_usd_samples_1" csv:"apr_reserve_usd_samples_1"`
+	AprLpFeesUSD2          entity.Float `db:"apr_lp_fees_usd_2" csv:"apr_lp_fees_usd_2"`
+	AprReserveUSDSum2      entity.Float `db:"apr_reserve_usd_sum_2" csv:"apr_reserve_usd_sum_2"`
+	AprReserveUSDSamples2  entity.Int   `db:"apr_reserve_usd_samples_2" csv:"apr_reserve_usd_samples_2"`
+	AprLpFeesUSD3          entity.Float `db:"apr_lp_fees_usd_3" csv:"apr_lp_fees_usd_3"`
+	AprReserveUSDSum3      entity.Float `db:"apr_reserve_usd_sum_3" csv:"apr_reserve_usd_sum_3"`
+	AprReserveUSDSamples3  entity.Int   `db:"apr_reserve_usd_samples_3" csv:"apr_reserve_usd_samples_3"`
+	AprLpFeesUSD4          entity.Float `db:"apr_lp_fees_usd_4" csv:"apr_lp_fees_usd_4"`
+	AprReserveUSDSum4      entity.Float `db:"apr_reserve_usd_sum_4" csv:"apr_reserve_usd_sum_4"`
+	AprReserveUSDSamples4  entity.Int   `db:"apr_reserve_usd_samples_4" csv:"apr_reserve_usd_samples_4"`
+	AprLpFeesUSD5          entity.Float `db:"apr_lp_fees_usd_5" csv:"apr_lp_fees_usd_5"`
+	AprReserveUSDSum5      entity.Float `db:"apr_reserve_usd_sum_5" csv:"apr_reserve_usd_sum_5"`
+	AprReserveUSDSamples5  entity.Int   `db:"apr_reserve_usd_samples_5" csv:"apr_reserve_usd_samples_5"`
+	AprLpFeesUSD6          entity.Float `db:"apr_lp_fees_usd_6" csv:"apr_lp_fees_usd_6"`
+	AprReserveUSDSum6      entity.Float `db:"apr_reserve_usd_sum_6" csv:"apr_reserve_usd_sum_6"`
+	AprReserveUSDSamples6  entity.Int   `db:"apr_reserve_usd_samples_6" csv:"apr_reserve_usd_samples_6"`
 	LiquidityProviderCount entity.Int   `db:"liquidity_provider_count" csv:"liquidity_provider_count"`
 	Timestamp              entity.Int   `db:"timestamp" csv:"timestamp"`
 	Block                  entity.Int   `db:"block" csv:"block"`
@@ -3001,11 +4570,40 @@ func NewPair(id string) *Pair {
 		TrackedReserveETH:      FL(0),
 		Token0Price:            FL(0),
 		Token1Price:            FL(0),
+		Price0CumulativeLast:   IL(0),
+		Price1CumulativeLast:   IL(0),
 		VolumeToken0:           FL(0),
 		VolumeToken1:           FL(0),
 		VolumeUSD:              FL(0),
 		UntrackedVolumeUSD:     FL(0),
 		TxCount:                IL(0),
+		FeesToken0:             FL(0),
+		FeesToken1:             FL(0),
+		FeesUSD:                FL(0),
+		LpFeesUSD:              FL(0),
+		ProtocolFeesUSD:        FL(0),
+		Apr7D:                  FL(0),
+		AprLpFeesUSD0:          FL(0),
+		AprReserveUSDSum0:      FL(0),
+		AprReserveUSDSamples0:  IL(0),
+		AprLpFeesUSD1:          FL(0),
+		AprReserveUSDSum1:      FL(0),
+		AprReserveUSDSamples1:  IL(0),
+		AprLpFeesUSD2:          FL(0),
+		AprReserveUSDSum2:      FL(0),
+		AprReserveUSDSamples2:  IL(0),
+		AprLpFeesUSD3:          FL(0),
+		AprReserveUSDSum3:      FL(0),
+		AprReserveUSDSamples3:  IL(0),
+		AprLpFeesUSD4:          FL(0),
+		AprReserveUSDSum4:      FL(0),
+		AprReserveUSDSamples4:  IL(0),
+		AprLpFeesUSD5:          FL(0),
+		AprReserveUSDSum5:      FL(0),
+		AprReserveUSDSamples5:  IL(0),
+		AprLpFeesUSD6:          FL(0),
+		AprReserveUSDSum6:      FL(0),
+		AprReserveUSDSamples6:  IL(0),
 		LiquidityProviderCount: IL(0),
 		Timestamp:              IL(0),
 		Block:                  IL(0),
@@ -3031,6 +4629,9 @@ func (next *Pair) Merge(step int, cached *Pair) {
 			next.Reserve1 = cached.Reserve1
 			next.Token0Price = cached.Token0Price
 			next.Token1Price = cached.Token1Price
+			next.Price0CumulativeLast = cached.Price0CumulativeLast
+			next.Price1CumulativeLast = cached.Price1CumulativeLast
+			next.BlockTimestampLast = cached.BlockTimestampLast
 		}
 	}
 	if step == 4 {
@@ -3046,8 +4647,36 @@ func (next *Pair) Merge(step int, cached *Pair) {
 		next.VolumeUSD = entity.FloatAdd(next.VolumeUSD, cached.VolumeUSD)
 		next.UntrackedVolumeUSD = entity.FloatAdd(next.UntrackedVolumeUSD, cached.UntrackedVolumeUSD)
 		next.TxCount = entity.IntAdd(next.TxCount, cached.TxCount)
+		next.FeesToken0 = entity.FloatAdd(next.FeesToken0, cached.FeesToken0)
+		next.FeesToken1 = entity.FloatAdd(next.FeesToken1, cached.FeesToken1)
+		next.FeesUSD = entity.FloatAdd(next.FeesUSD, cached.FeesUSD)
+		next.LpFeesUSD = entity.FloatAdd(next.LpFeesUSD, cached.LpFeesUSD)
+		next.ProtocolFeesUSD = entity.FloatAdd(next.ProtocolFeesUSD, cached.ProtocolFeesUSD)
 		next.LiquidityProviderCount = entity.IntAdd(next.LiquidityProviderCount, cached.LiquidityProviderCount)
 		if next.MutatedOnStep != 4 {
+			next.Apr7D = cached.Apr7D
+			next.AprWindowTimestamp = cached.AprWindowTimestamp
+			next.AprLpFeesUSD0 = cached.AprLpFeesUSD0
+			next.AprReserveUSDSum0 = cached.AprReserveUSDSum0
+			next.AprReserveUSDSamples0 = cached.AprReserveUSDSamples0
+			next.AprLpFeesUSD1 = cached.AprLpFeesUSD1
+			next.AprReserveUSDSum1 = cached.AprReserveUSDSum1
+			next.AprReserveUSDSamples1 = cached.AprReserveUSDSamples1
+			next.AprLpFeesUSD2 = cached.AprLpFeesUSD2
+			next.AprReserveUSDSum2 = cached.AprReserveUSDSum2
+			next.AprReserveUSDSamples2 = cached.AprReserveUSDSamples2
+			next.AprLpFeesUSD3 = cached.AprLpFeesUSD3
+			next.AprReserveUSDSum3 = cached.AprReserveUSDSum3
+			next.AprReserveUSDSamples3 = cached.AprReserveUSDSamples3
+			next.AprLpFeesUSD4 = cached.AprLpFeesUSD4
+			next.AprReserveUSDSum4 = cached.AprReserveUSDSum4
+			next.AprReserveUSDSamples4 = cached.AprReserveUSDSamples4
+			next.AprLpFeesUSD5 = cached.AprLpFeesUSD5
+			next.AprReserveUSDSum5 = cached.AprReserveUSDSum5
+			next.AprReserveUSDSamples5 = cached.AprReserveUSDSamples5
+			next.AprLpFeesUSD6 = cached.AprLpFeesUSD6
+			next.AprReserveUSDSum6 = cached.AprReserveUSDSum6
+			next.AprReserveUSDSamples6 = cached.AprReserveUSDSamples6
 		}
 	}
 }
@@ -3055,27 +4684,82 @@ func (next *Pair) Merge(step int, cached *Pair) {
 // PairHourData
 type PairHourData struct {
 	entity.Base
-	Date         int64        `db:"date" csv:"date"`
-	Pair         string       `db:"pair" csv:"pair"`
-	Reserve0     entity.Float `db:"reserve_0" csv:"reserve_0"`
-	Reserve1     entity.Float `db:"reserve_1" csv:"reserve_1"`
-	ReserveUSD   entity.Float `db:"reserve_usd" csv:"reserve_usd"`
-	VolumeToken0 entity.Float `db:"volume_token_0" csv:"volume_token_0"`
-	VolumeToken1 entity.Float `db:"volume_token_1" csv:"volume_token_1"`
-	VolumeUSD    entity.Float `db:"volume_usd" csv:"volume_usd"`
-	TxCount      entity.Int   `db:"tx_count" csv:"tx_count"`
+	Date                 int64        `db:"date" csv:"date"`
+	Pair                 string       `db:"pair" csv:"pair"`
+	Reserve0             entity.Float `db:"reserve_0" csv:"reserve_0"`
+	Reserve1             entity.Float `db:"reserve_1" csv:"reserve_1"`
+	ReserveUSD           entity.Float `db:"reserve_usd" csv:"reserve_usd"`
+	Price0CumulativeLast entity.Int   `db:"price_0_cumulative_last" csv:"price_0_cumulative_last"`
+	Price1CumulativeLast entity.Int   `db:"price_1_cumulative_last" csv:"price_1_cumulative_last"`
+	BlockTimestampLast   int64        `db:"block_timestamp_last" csv:"block_timestamp_last"`
+	Token0PriceOpen      entity.Float `db:"token_0_price_open" csv:"token_0_price_open"`
+	Token0PriceHigh      entity.Float `db:"token_0_price_high" csv:"token_0_price_high"`
+	Token0PriceLow       entity.Float `db:"token_0_price_low" csv:"token_0_price_low"`
+	Token0PriceClose     entity.Float `db:"token_0_price_close" csv:"token_0_price_close"`
+	Token1PriceOpen      entity.Float `db:"token_1_price_open" csv:"token_1_price_open"`
+	Token1PriceHigh      entity.Float `db:"token_1_price_high" csv:"token_1_price_high"`
+	Token1PriceLow       entity.Float `db:"token_1_price_low" csv:"token_1_price_low"`
+	Token1PriceClose     entity.Float `db:"token_1_price_close" csv:"token_1_price_close"`
+	Token0PriceUSDOpen   entity.Float `db:"token_0_price_usd_open" csv:"token_0_price_usd_open"`
+	Token0PriceUSDHigh   entity.Float `db:"token_0_price_usd_high" csv:"token_0_price_usd_high"`
+	Token0PriceUSDLow    entity.Float `db:"token_0_price_usd_low" csv:"token_0_price_usd_low"`
+	Token0PriceUSDClose  entity.Float `db:"token_0_price_usd_close" csv:"token_0_price_usd_close"`
+	Token1PriceUSDOpen   entity.Float `db:"token_1_price_usd_open" csv:"token_1_price_usd_open"`
+	Token1PriceUSDHigh   entity.Float `db:"token_1_price_usd_high" csv:"token_1_price_usd_high"`
+	Token1PriceUSDLow    entity.Float `db:"token_1_price_usd_low" csv:"token_1_price_usd_low"`
+	Token1PriceUSDClose  entity.Float `db:"token_1_price_usd_close" csv:"token_1_price_usd_close"`
+	VolumeToken0         entity.Float `db:"volume_token_0" csv:"volume_token_0"`
+	VolumeToken1         entity.Float `db:"volume_token_1" csv:"volume_token_1"`
+	VolumeUSD            entity.Float `db:"volume_usd" csv:"volume_usd"`
+	FeesToken0           entity.Float `db:"fees_token_0" csv:"fees_token_0"`
+	FeesToken1           entity.Float `db:"fees_token_1" csv:"fees_token_1"`
+	FeesUSD              entity.Float `db:"fees_usd" csv:"fees_usd"`
+	LpFeesUSD            entity.Float `db:"lp_fees_usd" csv:"lp_fees_usd"`
+	ProtocolFeesUSD      entity.Float `db:"protocol_fees_usd" csv:"protocol_fees_usd"`
+	ReserveUSDSum        entity.Float `db:"reserve_usd_sum" csv:"reserve_usd_sum"`
+	ReserveUSDSamples    entity.Int   `db:"reserve_usd_samples" csv:"reserve_usd_samples"`
+	Apr                  entity.Float `db:"apr" csv:"apr"`
+	Apy                  entity.Float `db:"apy" csv:"apy"`
+	TxCount              entity.Int   `db:"tx_count" csv:"tx_count"`
 }
 
 func NewPairHourData(id string) *PairHourData {
 	return &PairHourData{
-		Base:         entity.NewBase(id),
-		Reserve0:     FL(0),
-		Reserve1:     FL(0),
-		ReserveUSD:   FL(0),
-		VolumeToken0: FL(0),
-		VolumeToken1: FL(0),
-		VolumeUSD:    FL(0),
-		TxCount:      IL(0),
+		Base:                 entity.NewBase(id),
+		Reserve0:             FL(0),
+		Reserve1:             FL(0),
+		ReserveUSD:           FL(0),
+		Price0CumulativeLast: IL(0),
+		Price1CumulativeLast: IL(0),
+		Token0PriceOpen:      FL(0),
+		Token0PriceHigh:      FL(0),
+		Token0PriceLow:       FL(0),
+		Token0PriceClose:     FL(0),
+		Token1PriceOpen:      FL(0),
+		Token1PriceHigh:      FL(0),
+		Token1PriceLow:       FL(0),
+		Token1PriceClose:     FL(0),
+		Token0PriceUSDOpen:   FL(0),
+		Token0PriceUSDHigh:   FL(0),
+		Token0PriceUSDLow:    FL(0),
+		Token0PriceUSDClose:  FL(0),
+		Token1PriceUSDOpen:   FL(0),
+		Token1PriceUSDHigh:   FL(0),
+		Token1PriceUSDLow:    FL(0),
+		Token1PriceUSDClose:  FL(0),
+		VolumeToken0:         FL(0),
+		VolumeToken1:         FL(0),
+		VolumeUSD:            FL(0),
+		FeesToken0:           FL(0),
+		FeesToken1:           FL(0),
+		FeesUSD:              FL(0),
+		LpFeesUSD:            FL(0),
+		ProtocolFeesUSD:      FL(0),
+		ReserveUSDSum:        FL(0),
+		ReserveUSDSamples:    IL(0),
+		Apr:                  FL(0),
+		Apy:                  FL(0),
+		TxCount:              IL(0),
 	}
 }
 
@@ -3087,12 +4771,40 @@ func (next *PairHourData) Merge(step int, cached *PairHourData) {
 		next.VolumeToken0 = entity.FloatAdd(next.VolumeToken0, cached.VolumeToken0)
 		next.VolumeToken1 = entity.FloatAdd(next.VolumeToken1, cached.VolumeToken1)
 		next.VolumeUSD = entity.FloatAdd(next.VolumeUSD, cached.VolumeUSD)
+		next.FeesToken0 = entity.FloatAdd(next.FeesToken0, cached.FeesToken0)
+		next.FeesToken1 = entity.FloatAdd(next.FeesToken1, cached.FeesToken1)
+		next.FeesUSD = entity.FloatAdd(next.FeesUSD, cached.FeesUSD)
+		next.LpFeesUSD = entity.FloatAdd(next.LpFeesUSD, cached.LpFeesUSD)
+		next.ProtocolFeesUSD = entity.FloatAdd(next.ProtocolFeesUSD, cached.ProtocolFeesUSD)
+		next.ReserveUSDSum = entity.FloatAdd(next.ReserveUSDSum, cached.ReserveUSDSum)
+		next.ReserveUSDSamples = entity.IntAdd(next.ReserveUSDSamples, cached.ReserveUSDSamples)
 		next.TxCount = entity.IntAdd(next.TxCount, cached.TxCount)
 		if next.MutatedOnStep != 4 {
 			next.Date = cached.Date
 			next.Pair = cached.Pair
 			next.Reserve0 = cached.Reserve0
 			next.Reserve1 = cached.Reserve1
+			next.Price0CumulativeLast = cached.Price0CumulativeLast
+			next.Price1CumulativeLast = cached.Price1CumulativeLast
+			next.BlockTimestampLast = cached.BlockTimestampLast
+			next.Token0PriceOpen = cached.Token0PriceOpen
+			next.Token0PriceHigh = cached.Token0PriceHigh
+			next.Token0PriceLow = cached.Token0PriceLow
+			next.Token0PriceClose = cached.Token0PriceClose
+			next.Token1PriceOpen = cached.Token1PriceOpen
+			next.Token1PriceHigh = cached.Token1PriceHigh
+			next.Token1PriceLow = cached.Token1PriceLow
+			next.Token1PriceClose = cached.Token1PriceClose
+			next.Token0PriceUSDOpen = cached.Token0PriceUSDOpen
+			next.Token0PriceUSDHigh = cached.Token0PriceUSDHigh
+			next.Token0PriceUSDLow = cached.Token0PriceUSDLow
+			next.Token0PriceUSDClose = cached.Token0PriceUSDClose
+			next.Token1PriceUSDOpen = cached.Token1PriceUSDOpen
+			next.Token1PriceUSDHigh = cached.Token1PriceUSDHigh
+			next.Token1PriceUSDLow = cached.Token1PriceUSDLow
+			next.Token1PriceUSDClose = cached.Token1PriceUSDClose
+			next.Apr = cached.Apr
+			next.Apy = cached.Apy
 		}
 	}
 }
@@ -3100,31 +4812,86 @@ func (next *PairHourData) Merge(step int, cached *PairHourData) {
 // PairDayData
 type PairDayData struct {
 	entity.Base
-	Date         int64        `db:"date" csv:"date"`
-	Pair         string       `db:"pair" csv:"pair"`
-	Token0       string       `db:"token_0" csv:"token_0"`
-	Token1       string       `db:"token_1" csv:"token_1"`
-	Reserve0     entity.Float `db:"reserve_0" csv:"reserve_0"`
-	Reserve1     entity.Float `db:"reserve_1" csv:"reserve_1"`
-	TotalSupply  entity.Float `db:"total_supply" csv:"total_supply"`
-	ReserveUSD   entity.Float `db:"reserve_usd" csv:"reserve_usd"`
-	VolumeToken0 entity.Float `db:"volume_token_0" csv:"volume_token_0"`
-	VolumeToken1 entity.Float `db:"volume_token_1" csv:"volume_token_1"`
-	VolumeUSD    entity.Float `db:"volume_usd" csv:"volume_usd"`
-	TxCount      entity.Int   `db:"tx_count" csv:"tx_count"`
+	Date                 int64        `db:"date" csv:"date"`
+	Pair                 string       `db:"pair" csv:"pair"`
+	Token0               string       `db:"token_0" csv:"token_0"`
+	Token1               string       `db:"token_1" csv:"token_1"`
+	Reserve0             entity.Float `db:"reserve_0" csv:"reserve_0"`
+	Reserve1             entity.Float `db:"reserve_1" csv:"reserve_1"`
+	TotalSupply          entity.Float `db:"total_supply" csv:"total_supply"`
+	ReserveUSD           entity.Float `db:"reserve_usd" csv:"reserve_usd"`
+	Price0CumulativeLast entity.Int   `db:"price_0_cumulative_last" csv:"price_0_cumulative_last"`
+	Price1CumulativeLast entity.Int   `db:"price_1_cumulative_last" csv:"price_1_cumulative_last"`
+	BlockTimestampLast   int64        `db:"block_timestamp_last" csv:"block_timestamp_last"`
+	Token0PriceOpen      entity.Float `db:"token_0_price_open" csv:"token_0_price_open"`
+	Token0PriceHigh      entity.Float `db:"token_0_price_high" csv:"token_0_price_high"`
+	Token0PriceLow       entity.Float `db:"token_0_price_low" csv:"token_0_price_low"`
+	Token0PriceClose     entity.Float `db:"token_0_price_close" csv:"token_0_price_close"`
+	Token1PriceOpen      entity.Float `db:"token_1_price_open" csv:"token_1_price_open"`
+	Token1PriceHigh      entity.Float `db:"token_1_price_high" csv:"token_1_price_high"`
+	Token1PriceLow       entity.Float `db:"token_1_price_low" csv:"token_1_price_low"`
+	Token1PriceClose     entity.Float `db:"token_1_price_close" csv:"token_1_price_close"`
+	Token0PriceUSDOpen   entity.Float `db:"token_0_price_usd_open" csv:"token_0_price_usd_open"`
+	Token0PriceUSDHigh   entity.Float `db:"token_0_price_usd_high" csv:"token_0_price_usd_high"`
+	Token0PriceUSDLow    entity.Float `db:"token_0_price_usd_low" csv:"token_0_price_usd_low"`
+	Token0PriceUSDClose  entity.Float `db:"token_0_price_usd_close" csv:"token_0_price_usd_close"`
+	Token1PriceUSDOpen   entity.Float `db:"token_1_price_usd_open" csv:"token_1_price_usd_open"`
+	Token1PriceUSDHigh   entity.Float `db:"token_1_price_usd_high" csv:"token_1_price_usd_high"`
+	Token1PriceUSDLow    entity.Float `db:"token_1_price_usd_low" csv:"token_1_price_usd_low"`
+	Token1PriceUSDClose  entity.Float `db:"token_1_price_usd_close" csv:"token_1_price_usd_close"`
+	VolumeToken0         entity.Float `db:"volume_token_0" csv:"volume_token_0"`
+	VolumeToken1         entity.Float `db:"volume_token_1" csv:"volume_token_1"`
+	VolumeUSD            entity.Float `db:"volume_usd" csv:"volume_usd"`
+	FeesToken0           entity.Float `db:"fees_token_0" csv:"fees_token_0"`
+	FeesToken1           entity.Float `db:"fees_token_1" csv:"fees_token_1"`
+	FeesUSD              entity.Float `db:"fees_usd" csv:"fees_usd"`
+	LpFeesUSD            entity.Float `db:"lp_fees_usd" csv:"lp_fees_usd"`
+	ProtocolFeesUSD      entity.Float `db:"protocol_fees_usd" csv:"protocol_fees_usd"`
+	ReserveUSDSum        entity.Float `db:"reserve_usd_sum" csv:"reserve_usd_sum"`
+	ReserveUSDSamples    entity.Int   `db:"reserve_usd_samples" csv:"reserve_usd_samples"`
+	Apr                  entity.Float `db:"apr" csv:"apr"`
+	Apy                  entity.Float `db:"apy" csv:"apy"`
+	TxCount              entity.Int   `db:"tx_count" csv:"tx_count"`
 }
 
 func NewPairDayData(id string) *PairDayData {
 	return &PairDayData{
-		Base:         entity.NewBase(id),
-		Reserve0:     FL(0),
-		Reserve1:     FL(0),
-		TotalSupply:  FL(0),
-		ReserveUSD:   FL(0),
-		VolumeToken0: FL(0),
-		VolumeToken1: FL(0),
-		VolumeUSD:    FL(0),
-		TxCount:      IL(0),
+		Base:                 entity.NewBase(id),
+		Reserve0:             FL(0),
+		Reserve1:             FL(0),
+		TotalSupply:          FL(0),
+		ReserveUSD:           FL(0),
+		Price0CumulativeLast: IL(0),
+		Price1CumulativeLast: IL(0),
+		Token0PriceOpen:      FL(0),
+		Token0PriceHigh:      FL(0),
+		Token0PriceLow:       FL(0),
+		Token0PriceClose:     FL(0),
+		Token1PriceOpen:      FL(0),
+		Token1PriceHigh:      FL(0),
+		Token1PriceLow:       FL(0),
+		Token1PriceClose:     FL(0),
+		Token0PriceUSDOpen:   FL(0),
+		Token0PriceUSDHigh:   FL(0),
+		Token0PriceUSDLow:    FL(0),
+		Token0PriceUSDClose:  FL(0),
+		Token1PriceUSDOpen:   FL(0),
+		Token1PriceUSDHigh:   FL(0),
+		Token1PriceUSDLow:    FL(0),
+		Token1PriceUSDClose:  FL(0),
+		VolumeToken0:         FL(0),
+		VolumeToken1:         FL(0),
+		VolumeUSD:            FL(0),
+		FeesToken0:           FL(0),
+		FeesToken1:           FL(0),
+		FeesUSD:              FL(0),
+		LpFeesUSD:            FL(0),
+		ProtocolFeesUSD:      FL(0),
+		ReserveUSDSum:        FL(0),
+		ReserveUSDSamples:    IL(0),
+		Apr:                  FL(0),
+		Apy:                  FL(0),
+		TxCount:              IL(0),
 	}
 }
 
@@ -3137,6 +4904,13 @@ func (next *PairDayData) Merge(step int, cached *PairDayData) {
 		next.VolumeToken0 = entity.FloatAdd(next.VolumeToken0, cached.VolumeToken0)
 		next.VolumeToken1 = entity.FloatAdd(next.VolumeToken1, cached.VolumeToken1)
 		next.VolumeUSD = entity.FloatAdd(next.VolumeUSD, cached.VolumeUSD)
+		next.FeesToken0 = entity.FloatAdd(next.FeesToken0, cached.FeesToken0)
+		next.FeesToken1 = entity.FloatAdd(next.FeesToken1, cached.FeesToken1)
+		next.FeesUSD = entity.FloatAdd(next.FeesUSD, cached.FeesUSD)
+		next.LpFeesUSD = entity.FloatAdd(next.LpFeesUSD, cached.LpFeesUSD)
+		next.ProtocolFeesUSD = entity.FloatAdd(next.ProtocolFeesUSD, cached.ProtocolFeesUSD)
+		next.ReserveUSDSum = entity.FloatAdd(next.ReserveUSDSum, cached.ReserveUSDSum)
+		next.ReserveUSDSamples = entity.IntAdd(next.ReserveUSDSamples, cached.ReserveUSDSamples)
 		next.TxCount = entity.IntAdd(next.TxCount, cached.TxCount)
 		if next.MutatedOnStep != 4 {
 			next.Date = cached.Date
@@ -3146,6 +4920,146 @@ func (next *PairDayData) Merge(step int, cached *PairDayData) {
 			next.Reserve0 = cached.Reserve0
 			next.Reserve1 = cached.Reserve1
 			next.ReserveUSD = cached.ReserveUSD
+			next.Price0CumulativeLast = cached.Price0CumulativeLast
+			next.Price1CumulativeLast = cached.Price1CumulativeLast
+			next.BlockTimestampLast = cached.BlockTimestampLast
+			next.Token0PriceOpen = cached.Token0PriceOpen
+			next.Token0PriceHigh = cached.Token0PriceHigh
+			next.Token0PriceLow = cached.Token0PriceLow
+			next.Token0PriceClose = cached.Token0PriceClose
+			next.Token1PriceOpen = cached.Token1PriceOpen
+			next.Token1PriceHigh = cached.Token1PriceHigh
+			next.Token1PriceLow = cached.Token1PriceLow
+			next.Token1PriceClose = cached.Token1PriceClose
+			next.Token0PriceUSDOpen = cached.Token0PriceUSDOpen
+			next.Token0PriceUSDHigh = cached.Token0PriceUSDHigh
+			next.Token0PriceUSDLow = cached.Token0PriceUSDLow
+			next.Token0PriceUSDClose = cached.Token0PriceUSDClose
+			next.Token1PriceUSDOpen = cached.Token1PriceUSDOpen
+			next.Token1PriceUSDHigh = cached.Token1PriceUSDHigh
+			next.Token1PriceUSDLow = cached.Token1PriceUSDLow
+			next.Token1PriceUSDClose = cached.Token1PriceUSDClose
+			next.Apr = cached.Apr
+			next.Apy = cached.Apy
+		}
+	}
+}
+
+// PairIntervalData
+type PairIntervalData struct {
+	entity.Base
+	Interval             string       `db:"interval" csv:"interval"`
+	Date                 int64        `db:"date" csv:"date"`
+	Pair                 string       `db:"pair" csv:"pair"`
+	Reserve0             entity.Float `db:"reserve_0" csv:"reserve_0"`
+	Reserve1             entity.Float `db:"reserve_1" csv:"reserve_1"`
+	ReserveUSD           entity.Float `db:"reserve_usd" csv:"reserve_usd"`
+	Price0CumulativeLast entity.Int   `db:"price_0_cumulative_last" csv:"price_0_cumulative_last"`
+	Price1CumulativeLast entity.Int   `db:"price_1_cumulative_last" csv:"price_1_cumulative_last"`
+	BlockTimestampLast   int64        `db:"block_timestamp_last" csv:"block_timestamp_last"`
+	Token0PriceOpen      entity.Float `db:"token_0_price_open" csv:"token_0_price_open"`
+	Token0PriceHigh      entity.Float `db:"token_0_price_high" csv:"token_0_price_high"`
+	Token0PriceLow       entity.Float `db:"token_0_price_low" csv:"token_0_price_low"`
+	Token0PriceClose     entity.Float `db:"token_0_price_close" csv:"token_0_price_close"`
+	Token1PriceOpen      entity.Float `db:"token_1_price_open" csv:"token_1_price_open"`
+	Token1PriceHigh      entity.Float `db:"token_1_price_high" csv:"token_1_price_high"`
+	Token1PriceLow       entity.Float `db:"token_1_price_low" csv:"token_1_price_low"`
+	Token1PriceClose     entity.Float `db:"token_1_price_close" csv:"token_1_price_close"`
+	Token0PriceUSDOpen   entity.Float `db:"token_0_price_usd_open" csv:"token_0_price_usd_open"`
+	Token0PriceUSDHigh   entity.Float `db:"token_0_price_usd_high" csv:"token_0_price_usd_high"`
+	Token0PriceUSDLow    entity.Float `db:"token_0_price_usd_low" csv:"token_0_price_usd_low"`
+	Token0PriceUSDClose  entity.Float `db:"token_0_price_usd_close" csv:"token_0_price_usd_close"`
+	Token1PriceUSDOpen   entity.Float `db:"token_1_price_usd_open" csv:"token_1_price_usd_open"`
+	Token1PriceUSDHigh   entity.Float `db:"token_1_price_usd_high" csv:"token_1_price_usd_high"`
+	Token1PriceUSDLow    entity.Float `db:"token_1_price_usd_low" csv:"token_1_price_usd_low"`
+	Token1PriceUSDClose  entity.Float `db:"token_1_price_usd_close" csv:"token_1_price_usd_close"`
+	VolumeToken0         entity.Float `db:"volume_token_0" csv:"volume_token_0"`
+	VolumeToken1         entity.Float `db:"volume_token_1" csv:"volume_token_1"`
+	VolumeUSD            entity.Float `db:"volume_usd" csv:"volume_usd"`
+	FeesToken0           entity.Float `db:"fees_token_0" csv:"fees_token_0"`
+	FeesToken1           entity.Float `db:"fees_token_1" csv:"fees_token_1"`
+	FeesUSD              entity.Float `db:"fees_usd" csv:"fees_usd"`
+	LpFeesUSD            entity.Float `db:"lp_fees_usd" csv:"lp_fees_usd"`
+	ProtocolFeesUSD      entity.Float `db:"protocol_fees_usd" csv:"protocol_fees_usd"`
+	TxCount              entity.Int   `db:"tx_count" csv:"tx_count"`
+}
+
+func NewPairIntervalData(id string) *PairIntervalData {
+	return &PairIntervalData{
+		Base:                 entity.NewBase(id),
+		Reserve0:             FL(0),
+		Reserve1:             FL(0),
+		ReserveUSD:           FL(0),
+		Price0CumulativeLast: IL(0),
+		Price1CumulativeLast: IL(0),
+		Token0PriceOpen:      FL(0),
+		Token0PriceHigh:      FL(0),
+		Token0PriceLow:       FL(0),
+		Token0PriceClose:     FL(0),
+		Token1PriceOpen:      FL(0),
+		Token1PriceHigh:      FL(0),
+		Token1PriceLow:       FL(0),
+		Token1PriceClose:     FL(0),
+		Token0PriceUSDOpen:   FL(0),
+		Token0PriceUSDHigh:   FL(0),
+		Token0PriceUSDLow:    FL(0),
+		Token0PriceUSDClose:  FL(0),
+		Token1PriceUSDOpen:   FL(0),
+		Token1PriceUSDHigh:   FL(0),
+		Token1PriceUSDLow:    FL(0),
+		Token1PriceUSDClose:  FL(0),
+		VolumeToken0:         FL(0),
+		VolumeToken1:         FL(0),
+		VolumeUSD:            FL(0),
+		FeesToken0:           FL(0),
+		FeesToken1:           FL(0),
+		FeesUSD:              FL(0),
+		LpFeesUSD:            FL(0),
+		ProtocolFeesUSD:      FL(0),
+		TxCount:              IL(0),
+	}
+}
+
+func (_ *PairIntervalData) SkipDBLookup() bool {
+	return false
+}
+func (next *PairIntervalData) Merge(step int, cached *PairIntervalData) {
+	if step == 5 {
+		next.VolumeToken0 = entity.FloatAdd(next.VolumeToken0, cached.VolumeToken0)
+		next.VolumeToken1 = entity.FloatAdd(next.VolumeToken1, cached.VolumeToken1)
+		next.VolumeUSD = entity.FloatAdd(next.VolumeUSD, cached.VolumeUSD)
+		next.FeesToken0 = entity.FloatAdd(next.FeesToken0, cached.FeesToken0)
+		next.FeesToken1 = entity.FloatAdd(next.FeesToken1, cached.FeesToken1)
+		next.FeesUSD = entity.FloatAdd(next.FeesUSD, cached.FeesUSD)
+		next.LpFeesUSD = entity.FloatAdd(next.LpFeesUSD, cached.LpFeesUSD)
+		next.ProtocolFeesUSD = entity.FloatAdd(next.ProtocolFeesUSD, cached.ProtocolFeesUSD)
+		next.TxCount = entity.IntAdd(next.TxCount, cached.TxCount)
+		if next.MutatedOnStep != 4 {
+			next.Interval = cached.Interval
+			next.Date = cached.Date
+			next.Pair = cached.Pair
+			next.Reserve0 = cached.Reserve0
+			next.Reserve1 = cached.Reserve1
+			next.ReserveUSD = cached.ReserveUSD
+			next.Price0CumulativeLast = cached.Price0CumulativeLast
+			next.Price1CumulativeLast = cached.Price1CumulativeLast
+			next.BlockTimestampLast = cached.BlockTimestampLast
+			next.Token0PriceOpen = cached.Token0PriceOpen
+			next.Token0PriceHigh = cached.Token0PriceHigh
+			next.Token0PriceLow = cached.Token0PriceLow
+			next.Token0PriceClose = cached.Token0PriceClose
+			next.Token1PriceOpen = cached.Token1PriceOpen
+			next.Token1PriceHigh = cached.Token1PriceHigh
+			next.Token1PriceLow = cached.Token1PriceLow
+			next.Token1PriceClose = cached.Token1PriceClose
+			next.Token0PriceUSDOpen = cached.Token0PriceUSDOpen
+			next.Token0PriceUSDHigh = cached.Token0PriceUSDHigh
+			next.Token0PriceUSDLow = cached.Token0PriceUSDLow
+			next.Token0PriceUSDClose = cached.Token0PriceUSDClose
+			next.Token1PriceUSDOpen = cached.Token1PriceUSDOpen
+			next.Token1PriceUSDHigh = cached.Token1PriceUSDHigh
+			next.Token1PriceUSDLow = cached.Token1PriceUSDLow
+			next.Token1PriceUSDClose = cached.Token1PriceUSDClose
 		}
 	}
 }
@@ -3153,17 +5067,22 @@ func (next *PairDayData) Merge(step int, cached *PairDayData) {
 // LiquidityPosition
 type LiquidityPosition struct {
 	entity.Base
-	User                  string       `db:"user" csv:"user"`
-	Pair                  string       `db:"pair" csv:"pair"`
-	LiquidityTokenBalance entity.Float `db:"liquidity_token_balance" csv:"liquidity_token_balance"`
-	Block                 int64        `db:"block" csv:"block"`
-	Timestamp             int64        `db:"timestamp" csv:"timestamp"`
+	User                        string       `db:"user" csv:"user"`
+	Pair                        string       `db:"pair" csv:"pair"`
+	LiquidityTokenBalance       entity.Float `db:"liquidity_token_balance" csv:"liquidity_token_balance"`
+	LastSnapshot                *string      `db:"last_snapshot,nullable" csv:"last_snapshot"`
+	StakedLiquidityTokenBalance entity.Float `db:"staked_liquidity_token_balance" csv:"staked_liquidity_token_balance"`
+	TotalLiquidityTokenBalance  entity.Float `db:"total_liquidity_token_balance" csv:"total_liquidity_token_balance"`
+	Block                       int64        `db:"block" csv:"block"`
+	Timestamp                   int64        `db:"timestamp" csv:"timestamp"`
 }
 
 func NewLiquidityPosition(id string) *LiquidityPosition {
 	return &LiquidityPosition{
-		Base:                  entity.NewBase(id),
-		LiquidityTokenBalance: FL(0),
+		Base:                        entity.NewBase(id),
+		LiquidityTokenBalance:       FL(0),
+		StakedLiquidityTokenBalance: FL(0),
+		TotalLiquidityTokenBalance:  FL(0),
 	}
 }
 
@@ -3171,44 +5090,106 @@ func (_ *LiquidityPosition) SkipDBLookup() bool {
 	return false
 }
 func (next *LiquidityPosition) Merge(step int, cached *LiquidityPosition) {
+	if step == 4 {
+		next.LiquidityTokenBalance = entity.FloatAdd(next.LiquidityTokenBalance, cached.LiquidityTokenBalance)
+		next.StakedLiquidityTokenBalance = entity.FloatAdd(next.StakedLiquidityTokenBalance, cached.StakedLiquidityTokenBalance)
+		next.TotalLiquidityTokenBalance = entity.FloatAdd(next.TotalLiquidityTokenBalance, cached.TotalLiquidityTokenBalance)
+		if next.MutatedOnStep != 3 {
+		}
+	}
 	if step == 5 {
 		if next.MutatedOnStep != 4 {
 			next.User = cached.User
 			next.Pair = cached.Pair
-			next.LiquidityTokenBalance = cached.LiquidityTokenBalance
+			next.LastSnapshot = cached.LastSnapshot
 			next.Block = cached.Block
 			next.Timestamp = cached.Timestamp
 		}
 	}
 }
 
+// LPAllowance
+type LPAllowance struct {
+	entity.Base
+	Owner                string      `db:"owner" csv:"owner"`
+	Spender              string      `db:"spender" csv:"spender"`
+	Pair                 string      `db:"pair" csv:"pair"`
+	Amount               entity.Int  `db:"amount" csv:"amount"`
+	Unlimited            entity.Bool `db:"unlimited" csv:"unlimited"`
+	LastUpdatedBlock     int64       `db:"last_updated_block" csv:"last_updated_block"`
+	LastUpdatedTimestamp int64       `db:"last_updated_timestamp" csv:"last_updated_timestamp"`
+}
+
+func NewLPAllowance(id string) *LPAllowance {
+	return &LPAllowance{
+		Base:   entity.NewBase(id),
+		Amount: IL(0),
+	}
+}
+
+func (_ *LPAllowance) SkipDBLookup() bool {
+	return false
+}
+func (next *LPAllowance) Merge(step int, cached *LPAllowance) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Owner = cached.Owner
+			next.Spender = cached.Spender
+			next.Pair = cached.Pair
+			next.Amount = cached.Amount
+			next.Unlimited = cached.Unlimited
+			next.LastUpdatedBlock = cached.LastUpdatedBlock
+			next.LastUpdatedTimestamp = cached.LastUpdatedTimestamp
+		}
+	}
+}
+
 // LiquidityPositionSnapshot
 type LiquidityPositionSnapshot struct {
 	entity.Base
-	LiquidityPosition         string       `db:"liquidity_position" csv:"liquidity_position"`
-	Timestamp                 int64        `db:"timestamp" csv:"timestamp"`
-	Block                     int64        `db:"block" csv:"block"`
-	User                      string       `db:"user" csv:"user"`
-	Pair                      string       `db:"pair" csv:"pair"`
-	Token0PriceUSD            entity.Float `db:"token_0_price_usd" csv:"token_0_price_usd"`
-	Token1PriceUSD            entity.Float `db:"token_1_price_usd" csv:"token_1_price_usd"`
-	Reserve0                  entity.Float `db:"reserve_0" csv:"reserve_0"`
-	Reserve1                  entity.Float `db:"reserve_1" csv:"reserve_1"`
-	ReserveUSD                entity.Float `db:"reserve_usd" csv:"reserve_usd"`
-	LiquidityTokenTotalSupply entity.Float `db:"liquidity_token_total_supply" csv:"liquidity_token_total_supply"`
-	LiquidityTokenBalance     entity.Float `db:"liquidity_token_balance" csv:"liquidity_token_balance"`
+	LiquidityPosition           string       `db:"liquidity_position" csv:"liquidity_position"`
+	Timestamp                   int64        `db:"timestamp" csv:"timestamp"`
+	Block                       int64        `db:"block" csv:"block"`
+	User                        string       `db:"user" csv:"user"`
+	Pair                        string       `db:"pair" csv:"pair"`
+	Token0PriceUSD              entity.Float `db:"token_0_price_usd" csv:"token_0_price_usd"`
+	Token1PriceUSD              entity.Float `db:"token_1_price_usd" csv:"token_1_price_usd"`
+	Reserve0                    entity.Float `db:"reserve_0" csv:"reserve_0"`
+	Reserve1                    entity.Float `db:"reserve_1" csv:"reserve_1"`
+	ReserveUSD                  entity.Float `db:"reserve_usd" csv:"reserve_usd"`
+	LiquidityTokenTotalSupply   entity.Float `db:"liquidity_token_total_supply" csv:"liquidity_token_total_supply"`
+	LiquidityTokenBalance       entity.Float `db:"liquidity_token_balance" csv:"liquidity_token_balance"`
+	StakedLiquidityTokenBalance entity.Float `db:"staked_liquidity_token_balance" csv:"staked_liquidity_token_balance"`
+	TotalLiquidityTokenBalance  entity.Float `db:"total_liquidity_token_balance" csv:"total_liquidity_token_balance"`
+	PreviousSnapshot            *string      `db:"previous_snapshot,nullable" csv:"previous_snapshot"`
+	Token0Amount                entity.Float `db:"token_0_amount" csv:"token_0_amount"`
+	Token1Amount                entity.Float `db:"token_1_amount" csv:"token_1_amount"`
+	ValueUSD                    entity.Float `db:"value_usd" csv:"value_usd"`
+	HodlValueUSD                entity.Float `db:"hodl_value_usd" csv:"hodl_value_usd"`
+	PnlUSD                      entity.Float `db:"pnl_usd" csv:"pnl_usd"`
+	ImpermanentLoss             entity.Float `db:"impermanent_loss" csv:"impermanent_loss"`
+	FeesAccruedUSD              entity.Float `db:"fees_accrued_usd" csv:"fees_accrued_usd"`
 }
 
 func NewLiquidityPositionSnapshot(id string) *LiquidityPositionSnapshot {
 	return &LiquidityPositionSnapshot{
-		Base:                      entity.NewBase(id),
-		Token0PriceUSD:            FL(0),
-		Token1PriceUSD:            FL(0),
-		Reserve0:                  FL(0),
-		Reserve1:                  FL(0),
-		ReserveUSD:                FL(0),
-		LiquidityTokenTotalSupply: FL(0),
-		LiquidityTokenBalance:     FL(0),
+		Base:                        entity.NewBase(id),
+		Token0PriceUSD:              FL(0),
+		Token1PriceUSD:              FL(0),
+		Reserve0:                    FL(0),
+		Reserve1:                    FL(0),
+		ReserveUSD:                  FL(0),
+		LiquidityTokenTotalSupply:   FL(0),
+		LiquidityTokenBalance:       FL(0),
+		StakedLiquidityTokenBalance: FL(0),
+		TotalLiquidityTokenBalance:  FL(0),
+		Token0Amount:                FL(0),
+		Token1Amount:                FL(0),
+		ValueUSD:                    FL(0),
+		HodlValueUSD:                FL(0),
+		PnlUSD:                      FL(0),
+		ImpermanentLoss:             FL(0),
+		FeesAccruedUSD:              FL(0),
 	}
 }
 
@@ -3230,6 +5211,16 @@ func (next *LiquidityPositionSnapshot) Merge(step int, cached *LiquidityPosition
 			next.Reserve1 = cached.Reserve1
 			next.ReserveUSD = cached.ReserveUSD
 			next.LiquidityTokenBalance = cached.LiquidityTokenBalance
+			next.StakedLiquidityTokenBalance = cached.StakedLiquidityTokenBalance
+			next.TotalLiquidityTokenBalance = cached.TotalLiquidityTokenBalance
+			next.PreviousSnapshot = cached.PreviousSnapshot
+			next.Token0Amount = cached.Token0Amount
+			next.Token1Amount = cached.Token1Amount
+			next.ValueUSD = cached.ValueUSD
+			next.HodlValueUSD = cached.HodlValueUSD
+			next.PnlUSD = cached.PnlUSD
+			next.ImpermanentLoss = cached.ImpermanentLoss
+			next.FeesAccruedUSD = cached.FeesAccruedUSD
 		}
 	}
 }
@@ -3363,28 +5354,38 @@ func (next *Burn) Merge(step int, cached *Burn) {
 // Swap
 type Swap struct {
 	entity.Base
-	Transaction string       `db:"transaction" csv:"transaction"`
-	Timestamp   entity.Int   `db:"timestamp" csv:"timestamp"`
-	Pair        string       `db:"pair" csv:"pair"`
-	Sender      string       `db:"sender" csv:"sender"`
-	Amount0In   entity.Float `db:"amount_0_in" csv:"amount_0_in"`
-	Amount1In   entity.Float `db:"amount_1_in" csv:"amount_1_in"`
-	Amount0Out  entity.Float `db:"amount_0_out" csv:"amount_0_out"`
-	Amount1Out  entity.Float `db:"amount_1_out" csv:"amount_1_out"`
-	To          string       `db:"to" csv:"to"`
-	LogIndex    *entity.Int  `db:"log_index,nullable" csv:"log_index"`
-	AmountUSD   entity.Float `db:"amount_usd" csv:"amount_usd"`
+	Transaction     string       `db:"transaction" csv:"transaction"`
+	Timestamp       entity.Int   `db:"timestamp" csv:"timestamp"`
+	Pair            string       `db:"pair" csv:"pair"`
+	Sender          string       `db:"sender" csv:"sender"`
+	Amount0In       entity.Float `db:"amount_0_in" csv:"amount_0_in"`
+	Amount1In       entity.Float `db:"amount_1_in" csv:"amount_1_in"`
+	Amount0Out      entity.Float `db:"amount_0_out" csv:"amount_0_out"`
+	Amount1Out      entity.Float `db:"amount_1_out" csv:"amount_1_out"`
+	To              string       `db:"to" csv:"to"`
+	LogIndex        *entity.Int  `db:"log_index,nullable" csv:"log_index"`
+	AmountUSD       entity.Float `db:"amount_usd" csv:"amount_usd"`
+	FeesToken0      entity.Float `db:"fees_token_0" csv:"fees_token_0"`
+	FeesToken1      entity.Float `db:"fees_token_1" csv:"fees_token_1"`
+	FeesUSD         entity.Float `db:"fees_usd" csv:"fees_usd"`
+	LpFeesUSD       entity.Float `db:"lp_fees_usd" csv:"lp_fees_usd"`
+	ProtocolFeesUSD entity.Float `db:"protocol_fees_usd" csv:"protocol_fees_usd"`
 }
 
 func NewSwap(id string) *Swap {
 	return &Swap{
-		Base:       entity.NewBase(id),
-		Timestamp:  IL(0),
-		Amount0In:  FL(0),
-		Amount1In:  FL(0),
-		Amount0Out: FL(0),
-		Amount1Out: FL(0),
-		AmountUSD:  FL(0),
+		Base:            entity.NewBase(id),
+		Timestamp:       IL(0),
+		Amount0In:       FL(0),
+		Amount1In:       FL(0),
+		Amount0Out:      FL(0),
+		Amount1Out:      FL(0),
+		AmountUSD:       FL(0),
+		FeesToken0:      FL(0),
+		FeesToken1:      FL(0),
+		FeesUSD:         FL(0),
+		LpFeesUSD:       FL(0),
+		ProtocolFeesUSD: FL(0),
 	}
 }
 
@@ -3405,18 +5406,337 @@ func (next *Swap) Merge(step int, cached *Swap) {
 			next.To = cached.To
 			next.LogIndex = cached.LogIndex
 			next.AmountUSD = cached.AmountUSD
+			next.FeesToken0 = cached.FeesToken0
+			next.FeesToken1 = cached.FeesToken1
+			next.FeesUSD = cached.FeesUSD
+			next.LpFeesUSD = cached.LpFeesUSD
+			next.ProtocolFeesUSD = cached.ProtocolFeesUSD
 		}
 	}
 }
 
-func (s *Subgraph) HandleBlock(block *pbcodec.Block) error {
-	idx := uint32(0)
-	s.CurrentBlockDynamicDataSources = make(map[string]*DynamicDataSourceXXX)
+// Claim
+type Claim struct {
+	entity.Base
+	Index       entity.Int   `db:"index" csv:"index"`
+	Account     string       `db:"account" csv:"account"`
+	Amount      entity.Float `db:"amount" csv:"amount"`
+	Week        entity.Int   `db:"week" csv:"week"`
+	Block       int64        `db:"block" csv:"block"`
+	Timestamp   int64        `db:"timestamp" csv:"timestamp"`
+	Transaction string       `db:"transaction" csv:"transaction"`
+}
 
-	for _, trace := range block.TransactionTraces {
-		logs := trace.Logs()
-		for _, log := range logs {
-			var ethLog interface{} = log
+func NewClaim(id string) *Claim {
+	return &Claim{
+		Base:   entity.NewBase(id),
+		Index:  IL(0),
+		Amount: FL(0),
+		Week:   IL(0),
+	}
+}
+
+func (_ *Claim) SkipDBLookup() bool {
+	return false
+}
+func (next *Claim) Merge(step int, cached *Claim) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Index = cached.Index
+			next.Account = cached.Account
+			next.Amount = cached.Amount
+			next.Week = cached.Week
+			next.Block = cached.Block
+			next.Timestamp = cached.Timestamp
+			next.Transaction = cached.Transaction
+		}
+	}
+}
+
+// MerkleRoot
+type MerkleRoot struct {
+	entity.Base
+	Root        string     `db:"root" csv:"root"`
+	Week        entity.Int `db:"week" csv:"week"`
+	Block       int64      `db:"block" csv:"block"`
+	Timestamp   int64      `db:"timestamp" csv:"timestamp"`
+	Transaction string     `db:"transaction" csv:"transaction"`
+}
+
+func NewMerkleRoot(id string) *MerkleRoot {
+	return &MerkleRoot{
+		Base: entity.NewBase(id),
+		Week: IL(0),
+	}
+}
+
+func (_ *MerkleRoot) SkipDBLookup() bool {
+	return false
+}
+func (next *MerkleRoot) Merge(step int, cached *MerkleRoot) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Root = cached.Root
+			next.Week = cached.Week
+			next.Block = cached.Block
+			next.Timestamp = cached.Timestamp
+			next.Transaction = cached.Transaction
+		}
+	}
+}
+
+// SushiSupply
+type SushiSupply struct {
+	entity.Base
+	TotalSupply       entity.Float `db:"total_supply" csv:"total_supply"`
+	CirculatingSupply entity.Float `db:"circulating_supply" csv:"circulating_supply"`
+}
+
+func NewSushiSupply(id string) *SushiSupply {
+	return &SushiSupply{
+		Base:              entity.NewBase(id),
+		TotalSupply:       FL(0),
+		CirculatingSupply: FL(0),
+	}
+}
+
+func (_ *SushiSupply) SkipDBLookup() bool {
+	return false
+}
+func (next *SushiSupply) Merge(step int, cached *SushiSupply) {
+	if step == 4 {
+		next.TotalSupply = entity.FloatAdd(next.TotalSupply, cached.TotalSupply)
+		next.CirculatingSupply = entity.FloatAdd(next.CirculatingSupply, cached.CirculatingSupply)
+		if next.MutatedOnStep != 3 {
+		}
+	}
+}
+
+// SushiHolder
+type SushiHolder struct {
+	entity.Base
+	Balance          entity.Float `db:"balance" csv:"balance"`
+	Delegate         *string      `db:"delegate,nullable" csv:"delegate"`
+	LastUpdatedBlock int64        `db:"last_updated_block" csv:"last_updated_block"`
+}
+
+func NewSushiHolder(id string) *SushiHolder {
+	return &SushiHolder{
+		Base:    entity.NewBase(id),
+		Balance: FL(0),
+	}
+}
+
+func (_ *SushiHolder) SkipDBLookup() bool {
+	return false
+}
+func (next *SushiHolder) Merge(step int, cached *SushiHolder) {
+	if step == 4 {
+		next.Balance = entity.FloatAdd(next.Balance, cached.Balance)
+		if next.MutatedOnStep != 3 {
+		}
+	}
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Delegate = cached.Delegate
+			next.LastUpdatedBlock = cached.LastUpdatedBlock
+		}
+	}
+}
+
+// SushiSupplyDayData
+type SushiSupplyDayData struct {
+	entity.Base
+	Date              int64        `db:"date" csv:"date"`
+	TotalSupply       entity.Float `db:"total_supply" csv:"total_supply"`
+	CirculatingSupply entity.Float `db:"circulating_supply" csv:"circulating_supply"`
+}
+
+func NewSushiSupplyDayData(id string) *SushiSupplyDayData {
+	return &SushiSupplyDayData{
+		Base:              entity.NewBase(id),
+		TotalSupply:       FL(0),
+		CirculatingSupply: FL(0),
+	}
+}
+
+func (_ *SushiSupplyDayData) SkipDBLookup() bool {
+	return false
+}
+func (next *SushiSupplyDayData) Merge(step int, cached *SushiSupplyDayData) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Date = cached.Date
+			next.TotalSupply = cached.TotalSupply
+			next.CirculatingSupply = cached.CirculatingSupply
+		}
+	}
+}
+
+// SushiHolderDayData
+type SushiHolderDayData struct {
+	entity.Base
+	Date    int64        `db:"date" csv:"date"`
+	Holder  string       `db:"holder" csv:"holder"`
+	Balance entity.Float `db:"balance" csv:"balance"`
+}
+
+func NewSushiHolderDayData(id string) *SushiHolderDayData {
+	return &SushiHolderDayData{
+		Base:    entity.NewBase(id),
+		Balance: FL(0),
+	}
+}
+
+func (_ *SushiHolderDayData) SkipDBLookup() bool {
+	return false
+}
+func (next *SushiHolderDayData) Merge(step int, cached *SushiHolderDayData) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Date = cached.Date
+			next.Holder = cached.Holder
+			next.Balance = cached.Balance
+		}
+	}
+}
+
+// SushiDelegate
+type SushiDelegate struct {
+	entity.Base
+	Votes            entity.Float `db:"votes" csv:"votes"`
+	LastUpdatedBlock int64        `db:"last_updated_block" csv:"last_updated_block"`
+}
+
+func NewSushiDelegate(id string) *SushiDelegate {
+	return &SushiDelegate{
+		Base:  entity.NewBase(id),
+		Votes: FL(0),
+	}
+}
+
+func (_ *SushiDelegate) SkipDBLookup() bool {
+	return false
+}
+func (next *SushiDelegate) Merge(step int, cached *SushiDelegate) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Votes = cached.Votes
+			next.LastUpdatedBlock = cached.LastUpdatedBlock
+		}
+	}
+}
+
+// SushiVoteChange
+type SushiVoteChange struct {
+	entity.Base
+	Delegate      string       `db:"delegate" csv:"delegate"`
+	PreviousVotes entity.Float `db:"previous_votes" csv:"previous_votes"`
+	NewVotes      entity.Float `db:"new_votes" csv:"new_votes"`
+	Block         int64        `db:"block" csv:"block"`
+	Timestamp     int64        `db:"timestamp" csv:"timestamp"`
+	Transaction   string       `db:"transaction" csv:"transaction"`
+}
+
+func NewSushiVoteChange(id string) *SushiVoteChange {
+	return &SushiVoteChange{
+		Base:          entity.NewBase(id),
+		PreviousVotes: FL(0),
+		NewVotes:      FL(0),
+	}
+}
+
+func (_ *SushiVoteChange) SkipDBLookup() bool {
+	return false
+}
+func (next *SushiVoteChange) Merge(step int, cached *SushiVoteChange) {
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.Delegate = cached.Delegate
+			next.PreviousVotes = cached.PreviousVotes
+			next.NewVotes = cached.NewVotes
+			next.Block = cached.Block
+			next.Timestamp = cached.Timestamp
+			next.Transaction = cached.Transaction
+		}
+	}
+}
+
+// MasterChefPool
+type MasterChefPool struct {
+	entity.Base
+	Pair             *string `db:"pair,nullable" csv:"pair"`
+	LastUpdatedBlock int64   `db:"last_updated_block" csv:"last_updated_block"`
+}
+
+func NewMasterChefPool(id string) *MasterChefPool {
+	return &MasterChefPool{
+		Base: entity.NewBase(id),
+	}
+}
+
+func (_ *MasterChefPool) SkipDBLookup() bool {
+	return false
+}
+func (next *MasterChefPool) Merge(step int, cached *MasterChefPool) {
+	if step == 3 {
+		if next.MutatedOnStep != 2 {
+			next.Pair = cached.Pair
+		}
+	}
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.LastUpdatedBlock = cached.LastUpdatedBlock
+		}
+	}
+}
+
+// MasterChefStake
+type MasterChefStake struct {
+	entity.Base
+	Pool              string       `db:"pool" csv:"pool"`
+	User              string       `db:"user" csv:"user"`
+	Amount            entity.Float `db:"amount" csv:"amount"`
+	Pair              *string      `db:"pair,nullable" csv:"pair"`
+	LiquidityPosition *string      `db:"liquidity_position,nullable" csv:"liquidity_position"`
+	LastUpdatedBlock  int64        `db:"last_updated_block" csv:"last_updated_block"`
+}
+
+func NewMasterChefStake(id string) *MasterChefStake {
+	return &MasterChefStake{
+		Base:   entity.NewBase(id),
+		Amount: FL(0),
+	}
+}
+
+func (_ *MasterChefStake) SkipDBLookup() bool {
+	return false
+}
+func (next *MasterChefStake) Merge(step int, cached *MasterChefStake) {
+	if step == 3 {
+		next.Amount = entity.FloatAdd(next.Amount, cached.Amount)
+		if next.MutatedOnStep != 2 {
+			next.Pool = cached.Pool
+			next.User = cached.User
+			next.Pair = cached.Pair
+			next.LiquidityPosition = cached.LiquidityPosition
+		}
+	}
+	if step == 5 {
+		if next.MutatedOnStep != 4 {
+			next.LastUpdatedBlock = cached.LastUpdatedBlock
+		}
+	}
+}
+
+func (s *Subgraph) HandleBlock(block *pbcodec.Block) error {
+	idx := uint32(0)
+	s.CurrentBlockDynamicDataSources = make(map[string]*DynamicDataSourceXXX)
+
+	for _, trace := range block.TransactionTraces {
+		logs := trace.Logs()
+		for _, log := range logs {
+			var ethLog interface{} = log
 			eventLog := codecLogToEthLog(ethLog.(*pbcodec.Log), idx)
 			idx++
 			if bytes.Equal(FactoryAddressBytes, log.Address) || s.IsDynamicDataSource(eth.Address(log.Address).Pretty()) {
@@ -3469,6 +5789,10 @@ func (s *Subgraph) HandleEvent(ev interface{}) error {
 			return fmt.Errorf("handling FactoryPairCreated event: %w", err)
 		}
 
+	case *PairApprovalEvent:
+		if err := s.HandlePairApprovalEvent(e); err != nil {
+			return fmt.Errorf("handling PairApproval event: %w", err)
+		}
 	case *PairBurnEvent:
 		if err := s.HandlePairBurnEvent(e); err != nil {
 			return fmt.Errorf("handling PairBurn event: %w", err)
@@ -4008,6 +6332,16 @@ create table if not exists %%SCHEMA%%.user
 (
 	id text not null,
 
+	"usd_swapped" numeric not null,
+
+	"swap_count" numeric not null,
+
+	"active_position_count" numeric not null,
+
+	"first_seen_block" numeric not null,
+
+	"last_active_block" numeric not null,
+
 	vid bigserial not null constraint user_pkey primary key,
 	block_range int4range not null,
 	_updated_block_number numeric not null
@@ -4025,6 +6359,12 @@ create table if not exists %%SCHEMA%%.bundle
 
 	"eth_price" numeric not null,
 
+	"eth_price_block" numeric not null,
+
+	"pending_eth_price" numeric not null,
+
+	"pending_eth_price_block" numeric not null,
+
 	vid bigserial not null constraint bundle_pkey primary key,
 	block_range int4range not null,
 	_updated_block_number numeric not null
@@ -4033,6 +6373,112 @@ create table if not exists %%SCHEMA%%.bundle
 alter table %%SCHEMA%%.bundle owner to graph;
 alter sequence %%SCHEMA%%.bundle_vid_seq owned by %%SCHEMA%%.bundle.vid;
 alter table only %%SCHEMA%%.bundle alter column vid SET DEFAULT nextval('%%SCHEMA%%.bundle_vid_seq'::regclass);
+`
+
+	ddl.createTables["eth_price_hour_data"] = `
+create table if not exists %%SCHEMA%%.eth_price_hour_data
+(
+	id text not null,
+
+	"date" numeric not null,
+
+	"open" numeric not null,
+
+	"high" numeric not null,
+
+	"low" numeric not null,
+
+	"close" numeric not null,
+
+	vid bigserial not null constraint eth_price_hour_data_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.eth_price_hour_data owner to graph;
+alter sequence %%SCHEMA%%.eth_price_hour_data_vid_seq owned by %%SCHEMA%%.eth_price_hour_data.vid;
+alter table only %%SCHEMA%%.eth_price_hour_data alter column vid SET DEFAULT nextval('%%SCHEMA%%.eth_price_hour_data_vid_seq'::regclass);
+`
+
+	ddl.createTables["eth_price_day_data"] = `
+create table if not exists %%SCHEMA%%.eth_price_day_data
+(
+	id text not null,
+
+	"date" numeric not null,
+
+	"open" numeric not null,
+
+	"high" numeric not null,
+
+	"low" numeric not null,
+
+	"close" numeric not null,
+
+	vid bigserial not null constraint eth_price_day_data_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.eth_price_day_data owner to graph;
+alter sequence %%SCHEMA%%.eth_price_day_data_vid_seq owned by %%SCHEMA%%.eth_price_day_data.vid;
+alter table only %%SCHEMA%%.eth_price_day_data alter column vid SET DEFAULT nextval('%%SCHEMA%%.eth_price_day_data_vid_seq'::regclass);
+`
+
+	ddl.createTables["eth_price_source_data"] = `
+create table if not exists %%SCHEMA%%.eth_price_source_data
+(
+	id text not null,
+
+	"hour_data" text,
+
+	"day_data" text,
+
+	"pair" text not null,
+
+	"price" numeric not null,
+
+	"liquidity_eth" numeric not null,
+
+	"used" boolean not null,
+
+	vid bigserial not null constraint eth_price_source_data_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.eth_price_source_data owner to graph;
+alter sequence %%SCHEMA%%.eth_price_source_data_vid_seq owned by %%SCHEMA%%.eth_price_source_data.vid;
+alter table only %%SCHEMA%%.eth_price_source_data alter column vid SET DEFAULT nextval('%%SCHEMA%%.eth_price_source_data_vid_seq'::regclass);
+`
+
+	ddl.createTables["price_anomaly"] = `
+create table if not exists %%SCHEMA%%.price_anomaly
+(
+	id text not null,
+
+	"block" numeric not null,
+
+	"timestamp" numeric not null,
+
+	"transaction" text not null,
+
+	"pair" text not null,
+
+	"old_price" numeric not null,
+
+	"new_price" numeric not null,
+
+	"reason" text not null,
+
+	vid bigserial not null constraint price_anomaly_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.price_anomaly owner to graph;
+alter sequence %%SCHEMA%%.price_anomaly_vid_seq owned by %%SCHEMA%%.price_anomaly.vid;
+alter table only %%SCHEMA%%.price_anomaly alter column vid SET DEFAULT nextval('%%SCHEMA%%.price_anomaly_vid_seq'::regclass);
 `
 
 	ddl.createTables["factory"] = `
@@ -4048,6 +6494,12 @@ create table if not exists %%SCHEMA%%.factory
 
 	"untracked_volume_usd" numeric not null,
 
+	"fees_usd" numeric not null,
+
+	"lp_fees_usd" numeric not null,
+
+	"protocol_fees_usd" numeric not null,
+
 	"liquidity_usd" numeric not null,
 
 	"liquidity_eth" numeric not null,
@@ -4114,6 +6566,12 @@ create table if not exists %%SCHEMA%%.day_data
 
 	"untracked_volume" numeric not null,
 
+	"fees_usd" numeric not null,
+
+	"lp_fees_usd" numeric not null,
+
+	"protocol_fees_usd" numeric not null,
+
 	"liquidity_eth" numeric not null,
 
 	"liquidity_usd" numeric not null,
@@ -4143,6 +6601,8 @@ create table if not exists %%SCHEMA%%.token
 
 	"decimals" numeric not null,
 
+	"decimals_valid" boolean not null,
+
 	"total_supply" numeric not null,
 
 	"volume" numeric not null,
@@ -4157,6 +6617,10 @@ create table if not exists %%SCHEMA%%.token
 
 	"derived_eth" numeric not null,
 
+	"derived_eth_route" text[] not null,
+
+	"derived_eth_hops" numeric not null,
+
 	"whitelist_pairs" text[] not null,
 
 	vid bigserial not null constraint token_pkey primary key,
@@ -4229,6 +6693,10 @@ create table if not exists %%SCHEMA%%.token_day_data
 
 	"price_usd" numeric not null,
 
+	"total_supply" numeric not null,
+
+	"market_cap_usd" numeric not null,
+
 	vid bigserial not null constraint token_day_data_pkey primary key,
 	block_range int4range not null,
 	_updated_block_number numeric not null
@@ -4237,6 +6705,43 @@ create table if not exists %%SCHEMA%%.token_day_data
 alter table %%SCHEMA%%.token_day_data owner to graph;
 alter sequence %%SCHEMA%%.token_day_data_vid_seq owned by %%SCHEMA%%.token_day_data.vid;
 alter table only %%SCHEMA%%.token_day_data alter column vid SET DEFAULT nextval('%%SCHEMA%%.token_day_data_vid_seq'::regclass);
+`
+
+	ddl.createTables["token_interval_data"] = `
+create table if not exists %%SCHEMA%%.token_interval_data
+(
+	id text not null,
+
+	"interval" text not null,
+
+	"date" numeric not null,
+
+	"token" text not null,
+
+	"volume" numeric not null,
+
+	"volume_eth" numeric not null,
+
+	"volume_usd" numeric not null,
+
+	"tx_count" numeric not null,
+
+	"liquidity" numeric not null,
+
+	"liquidity_eth" numeric not null,
+
+	"liquidity_usd" numeric not null,
+
+	"price_usd" numeric not null,
+
+	vid bigserial not null constraint token_interval_data_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.token_interval_data owner to graph;
+alter sequence %%SCHEMA%%.token_interval_data_vid_seq owned by %%SCHEMA%%.token_interval_data.vid;
+alter table only %%SCHEMA%%.token_interval_data alter column vid SET DEFAULT nextval('%%SCHEMA%%.token_interval_data_vid_seq'::regclass);
 `
 
 	ddl.createTables["pair"] = `
@@ -4268,6 +6773,12 @@ create table if not exists %%SCHEMA%%.pair
 
 	"token_1_price" numeric not null,
 
+	"price_0_cumulative_last" numeric not null,
+
+	"price_1_cumulative_last" numeric not null,
+
+	"block_timestamp_last" numeric not null,
+
 	"volume_token_0" numeric not null,
 
 	"volume_token_1" numeric not null,
@@ -4278,6 +6789,62 @@ create table if not exists %%SCHEMA%%.pair
 
 	"tx_count" numeric not null,
 
+	"fees_token_0" numeric not null,
+
+	"fees_token_1" numeric not null,
+
+	"fees_usd" numeric not null,
+
+	"lp_fees_usd" numeric not null,
+
+	"protocol_fees_usd" numeric not null,
+
+	"apr_7_d" numeric not null,
+
+	"apr_window_timestamp" numeric not null,
+
+	"apr_lp_fees_usd_0" numeric not null,
+
+	"apr_reserve_usd_sum_0" numeric not null,
+
+	"apr_reserve_usd_samples_0" numeric not null,
+
+	"apr_lp_fees_usd_1" numeric not null,
+
+	"apr_reserve_usd_sum_1" numeric not null,
+
+	"apr_reserve_usd_samples_1" numeric not null,
+
+	"apr_lp_fees_usd_2" numeric not null,
+
+	"apr_reserve_usd_sum_2" numeric not null,
+
+	"apr_reserve_usd_samples_2" numeric not null,
+
+	"apr_lp_fees_usd_3" numeric not null,
+
+	"apr_reserve_usd_sum_3" numeric not null,
+
+	"apr_reserve_usd_samples_3" numeric not null,
+
+	"apr_lp_fees_usd_4" numeric not null,
+
+	"apr_reserve_usd_sum_4" numeric not null,
+
+	"apr_reserve_usd_samples_4" numeric not null,
+
+	"apr_lp_fees_usd_5" numeric not null,
+
+	"apr_reserve_usd_sum_5" numeric not null,
+
+	"apr_reserve_usd_samples_5" numeric not null,
+
+	"apr_lp_fees_usd_6" numeric not null,
+
+	"apr_reserve_usd_sum_6" numeric not null,
+
+	"apr_reserve_usd_samples_6" numeric not null,
+
 	"liquidity_provider_count" numeric not null,
 
 	"timestamp" numeric not null,
@@ -4309,12 +6876,68 @@ create table if not exists %%SCHEMA%%.pair_hour_data
 
 	"reserve_usd" numeric not null,
 
+	"price_0_cumulative_last" numeric not null,
+
+	"price_1_cumulative_last" numeric not null,
+
+	"block_timestamp_last" numeric not null,
+
+	"token_0_price_open" numeric not null,
+
+	"token_0_price_high" numeric not null,
+
+	"token_0_price_low" numeric not null,
+
+	"token_0_price_close" numeric not null,
+
+	"token_1_price_open" numeric not null,
+
+	"token_1_price_high" numeric not null,
+
+	"token_1_price_low" numeric not null,
+
+	"token_1_price_close" numeric not null,
+
+	"token_0_price_usd_open" numeric not null,
+
+	"token_0_price_usd_high" numeric not null,
+
+	"token_0_price_usd_low" numeric not null,
+
+	"token_0_price_usd_close" numeric not null,
+
+	"token_1_price_usd_open" numeric not null,
+
+	"token_1_price_usd_high" numeric not null,
+
+	"token_1_price_usd_low" numeric not null,
+
+	"token_1_price_usd_close" numeric not null,
+
 	"volume_token_0" numeric not null,
 
 	"volume_token_1" numeric not null,
 
 	"volume_usd" numeric not null,
 
+	"fees_token_0" numeric not null,
+
+	"fees_token_1" numeric not null,
+
+	"fees_usd" numeric not null,
+
+	"lp_fees_usd" numeric not null,
+
+	"protocol_fees_usd" numeric not null,
+
+	"reserve_usd_sum" numeric not null,
+
+	"reserve_usd_samples" numeric not null,
+
+	"apr" numeric not null,
+
+	"apy" numeric not null,
+
 	"tx_count" numeric not null,
 
 	vid bigserial not null constraint pair_hour_data_pkey primary key,
@@ -4348,12 +6971,68 @@ create table if not exists %%SCHEMA%%.pair_day_data
 
 	"reserve_usd" numeric not null,
 
+	"price_0_cumulative_last" numeric not null,
+
+	"price_1_cumulative_last" numeric not null,
+
+	"block_timestamp_last" numeric not null,
+
+	"token_0_price_open" numeric not null,
+
+	"token_0_price_high" numeric not null,
+
+	"token_0_price_low" numeric not null,
+
+	"token_0_price_close" numeric not null,
+
+	"token_1_price_open" numeric not null,
+
+	"token_1_price_high" numeric not null,
+
+	"token_1_price_low" numeric not null,
+
+	"token_1_price_close" numeric not null,
+
+	"token_0_price_usd_open" numeric not null,
+
+	"token_0_price_usd_high" numeric not null,
+
+	"token_0_price_usd_low" numeric not null,
+
+	"token_0_price_usd_close" numeric not null,
+
+	"token_1_price_usd_open" numeric not null,
+
+	"token_1_price_usd_high" numeric not null,
+
+	"token_1_price_usd_low" numeric not null,
+
+	"token_1_price_usd_close" numeric not null,
+
 	"volume_token_0" numeric not null,
 
 	"volume_token_1" numeric not null,
 
 	"volume_usd" numeric not null,
 
+	"fees_token_0" numeric not null,
+
+	"fees_token_1" numeric not null,
+
+	"fees_usd" numeric not null,
+
+	"lp_fees_usd" numeric not null,
+
+	"protocol_fees_usd" numeric not null,
+
+	"reserve_usd_sum" numeric not null,
+
+	"reserve_usd_samples" numeric not null,
+
+	"apr" numeric not null,
+
+	"apy" numeric not null,
+
 	"tx_count" numeric not null,
 
 	vid bigserial not null constraint pair_day_data_pkey primary key,
@@ -4364,6 +7043,89 @@ create table if not exists %%SCHEMA%%.pair_day_data
 alter table %%SCHEMA%%.pair_day_data owner to graph;
 alter sequence %%SCHEMA%%.pair_day_data_vid_seq owned by %%SCHEMA%%.pair_day_data.vid;
 alter table only %%SCHEMA%%.pair_day_data alter column vid SET DEFAULT nextval('%%SCHEMA%%.pair_day_data_vid_seq'::regclass);
+`
+
+	ddl.createTables["pair_interval_data"] = `
+create table if not exists %%SCHEMA%%.pair_interval_data
+(
+	id text not null,
+
+	"interval" text not null,
+
+	"date" numeric not null,
+
+	"pair" text not null,
+
+	"reserve_0" numeric not null,
+
+	"reserve_1" numeric not null,
+
+	"reserve_usd" numeric not null,
+
+	"price_0_cumulative_last" numeric not null,
+
+	"price_1_cumulative_last" numeric not null,
+
+	"block_timestamp_last" numeric not null,
+
+	"token_0_price_open" numeric not null,
+
+	"token_0_price_high" numeric not null,
+
+	"token_0_price_low" numeric not null,
+
+	"token_0_price_close" numeric not null,
+
+	"token_1_price_open" numeric not null,
+
+	"token_1_price_high" numeric not null,
+
+	"token_1_price_low" numeric not null,
+
+	"token_1_price_close" numeric not null,
+
+	"token_0_price_usd_open" numeric not null,
+
+	"token_0_price_usd_high" numeric not null,
+
+	"token_0_price_usd_low" numeric not null,
+
+	"token_0_price_usd_close" numeric not null,
+
+	"token_1_price_usd_open" numeric not null,
+
+	"token_1_price_usd_high" numeric not null,
+
+	"token_1_price_usd_low" numeric not null,
+
+	"token_1_price_usd_close" numeric not null,
+
+	"volume_token_0" numeric not null,
+
+	"volume_token_1" numeric not null,
+
+	"volume_usd" numeric not null,
+
+	"fees_token_0" numeric not null,
+
+	"fees_token_1" numeric not null,
+
+	"fees_usd" numeric not null,
+
+	"lp_fees_usd" numeric not null,
+
+	"protocol_fees_usd" numeric not null,
+
+	"tx_count" numeric not null,
+
+	vid bigserial not null constraint pair_interval_data_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.pair_interval_data owner to graph;
+alter sequence %%SCHEMA%%.pair_interval_data_vid_seq owned by %%SCHEMA%%.pair_interval_data.vid;
+alter table only %%SCHEMA%%.pair_interval_data alter column vid SET DEFAULT nextval('%%SCHEMA%%.pair_interval_data_vid_seq'::regclass);
 `
 
 	ddl.createTables["liquidity_position"] = `
@@ -4377,6 +7139,12 @@ create table if not exists %%SCHEMA%%.liquidity_position
 
 	"liquidity_token_balance" numeric not null,
 
+	"last_snapshot" text,
+
+	"staked_liquidity_token_balance" numeric not null,
+
+	"total_liquidity_token_balance" numeric not null,
+
 	"block" numeric not null,
 
 	"timestamp" numeric not null,
@@ -4389,6 +7157,35 @@ create table if not exists %%SCHEMA%%.liquidity_position
 alter table %%SCHEMA%%.liquidity_position owner to graph;
 alter sequence %%SCHEMA%%.liquidity_position_vid_seq owned by %%SCHEMA%%.liquidity_position.vid;
 alter table only %%SCHEMA%%.liquidity_position alter column vid SET DEFAULT nextval('%%SCHEMA%%.liquidity_position_vid_seq'::regclass);
+`
+
+	ddl.createTables["lp_allowance"] = `
+create table if not exists %%SCHEMA%%.lp_allowance
+(
+	id text not null,
+
+	"owner" text not null,
+
+	"spender" text not null,
+
+	"pair" text not null,
+
+	"amount" numeric not null,
+
+	"unlimited" boolean not null,
+
+	"last_updated_block" numeric not null,
+
+	"last_updated_timestamp" numeric not null,
+
+	vid bigserial not null constraint lp_allowance_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.lp_allowance owner to graph;
+alter sequence %%SCHEMA%%.lp_allowance_vid_seq owned by %%SCHEMA%%.lp_allowance.vid;
+alter table only %%SCHEMA%%.lp_allowance alter column vid SET DEFAULT nextval('%%SCHEMA%%.lp_allowance_vid_seq'::regclass);
 `
 
 	ddl.createTables["liquidity_position_snapshot"] = `
@@ -4420,6 +7217,26 @@ create table if not exists %%SCHEMA%%.liquidity_position_snapshot
 
 	"liquidity_token_balance" numeric not null,
 
+	"staked_liquidity_token_balance" numeric not null,
+
+	"total_liquidity_token_balance" numeric not null,
+
+	"previous_snapshot" text,
+
+	"token_0_amount" numeric not null,
+
+	"token_1_amount" numeric not null,
+
+	"value_usd" numeric not null,
+
+	"hodl_value_usd" numeric not null,
+
+	"pnl_usd" numeric not null,
+
+	"impermanent_loss" numeric not null,
+
+	"fees_accrued_usd" numeric not null,
+
 	vid bigserial not null constraint liquidity_position_snapshot_pkey primary key,
 	block_range int4range not null,
 	_updated_block_number numeric not null
@@ -4562,6 +7379,16 @@ create table if not exists %%SCHEMA%%.swap
 
 	"amount_usd" numeric not null,
 
+	"fees_token_0" numeric not null,
+
+	"fees_token_1" numeric not null,
+
+	"fees_usd" numeric not null,
+
+	"lp_fees_usd" numeric not null,
+
+	"protocol_fees_usd" numeric not null,
+
 	vid bigserial not null constraint swap_pkey primary key,
 	block_range int4range not null,
 	_updated_block_number numeric not null
@@ -4572,1157 +7399,2966 @@ alter sequence %%SCHEMA%%.swap_vid_seq owned by %%SCHEMA%%.swap.vid;
 alter table only %%SCHEMA%%.swap alter column vid SET DEFAULT nextval('%%SCHEMA%%.swap_vid_seq'::regclass);
 `
 
-	ddl.indexes["user"] = func() []*index {
-		var indexes []*index
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists user_block_range_closed on %%SCHEMA%%.user (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.user_block_range_closed;`,
-		})
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists user_id on %%SCHEMA%%.user (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.user_id;`,
-		})
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists user_updated_block_number on %%SCHEMA%%.user (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.user_updated_block_number;`,
-		})
+	ddl.createTables["claim"] = `
+create table if not exists %%SCHEMA%%.claim
+(
+	id text not null,
 
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists user_id_block_range_fake_excl on %%SCHEMA%%.user using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.user_id_block_range_fake_excl;`,
-		})
+	"index" numeric not null,
 
-		return indexes
-	}()
+	"account" text not null,
 
-	ddl.indexes["bundle"] = func() []*index {
-		var indexes []*index
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists bundle_block_range_closed on %%SCHEMA%%.bundle (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_block_range_closed;`,
-		})
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists bundle_id on %%SCHEMA%%.bundle (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_id;`,
-		})
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists bundle_updated_block_number on %%SCHEMA%%.bundle (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_updated_block_number;`,
-		})
+	"amount" numeric not null,
 
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists bundle_id_block_range_fake_excl on %%SCHEMA%%.bundle using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_id_block_range_fake_excl;`,
-		})
+	"week" numeric not null,
 
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists bundle_eth_price on %%SCHEMA%%.bundle using btree ("eth_price");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_eth_price;`,
-		})
+	"block" numeric not null,
 
-		return indexes
-	}()
+	"timestamp" numeric not null,
+
+	"transaction" text not null,
+
+	vid bigserial not null constraint claim_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.claim owner to graph;
+alter sequence %%SCHEMA%%.claim_vid_seq owned by %%SCHEMA%%.claim.vid;
+alter table only %%SCHEMA%%.claim alter column vid SET DEFAULT nextval('%%SCHEMA%%.claim_vid_seq'::regclass);
+`
+
+	ddl.createTables["merkle_root"] = `
+create table if not exists %%SCHEMA%%.merkle_root
+(
+	id text not null,
+
+	"root" text not null,
+
+	"week" numeric not null,
+
+	"block" numeric not null,
+
+	"timestamp" numeric not null,
+
+	"transaction" text not null,
+
+	vid bigserial not null constraint merkle_root_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.merkle_root owner to graph;
+alter sequence %%SCHEMA%%.merkle_root_vid_seq owned by %%SCHEMA%%.merkle_root.vid;
+alter table only %%SCHEMA%%.merkle_root alter column vid SET DEFAULT nextval('%%SCHEMA%%.merkle_root_vid_seq'::regclass);
+`
+
+	ddl.createTables["sushi_supply"] = `
+create table if not exists %%SCHEMA%%.sushi_supply
+(
+	id text not null,
+
+	"total_supply" numeric not null,
+
+	"circulating_supply" numeric not null,
+
+	vid bigserial not null constraint sushi_supply_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.sushi_supply owner to graph;
+alter sequence %%SCHEMA%%.sushi_supply_vid_seq owned by %%SCHEMA%%.sushi_supply.vid;
+alter table only %%SCHEMA%%.sushi_supply alter column vid SET DEFAULT nextval('%%SCHEMA%%.sushi_supply_vid_seq'::regclass);
+`
+
+	ddl.createTables["sushi_holder"] = `
+create table if not exists %%SCHEMA%%.sushi_holder
+(
+	id text not null,
+
+	"balance" numeric not null,
+
+	"delegate" text,
+
+	"last_updated_block" numeric not null,
+
+	vid bigserial not null constraint sushi_holder_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.sushi_holder owner to graph;
+alter sequence %%SCHEMA%%.sushi_holder_vid_seq owned by %%SCHEMA%%.sushi_holder.vid;
+alter table only %%SCHEMA%%.sushi_holder alter column vid SET DEFAULT nextval('%%SCHEMA%%.sushi_holder_vid_seq'::regclass);
+`
+
+	ddl.createTables["sushi_supply_day_data"] = `
+create table if not exists %%SCHEMA%%.sushi_supply_day_data
+(
+	id text not null,
+
+	"date" numeric not null,
+
+	"total_supply" numeric not null,
+
+	"circulating_supply" numeric not null,
+
+	vid bigserial not null constraint sushi_supply_day_data_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.sushi_supply_day_data owner to graph;
+alter sequence %%SCHEMA%%.sushi_supply_day_data_vid_seq owned by %%SCHEMA%%.sushi_supply_day_data.vid;
+alter table only %%SCHEMA%%.sushi_supply_day_data alter column vid SET DEFAULT nextval('%%SCHEMA%%.sushi_supply_day_data_vid_seq'::regclass);
+`
+
+	ddl.createTables["sushi_holder_day_data"] = `
+create table if not exists %%SCHEMA%%.sushi_holder_day_data
+(
+	id text not null,
+
+	"date" numeric not null,
+
+	"holder" text not null,
+
+	"balance" numeric not null,
+
+	vid bigserial not null constraint sushi_holder_day_data_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.sushi_holder_day_data owner to graph;
+alter sequence %%SCHEMA%%.sushi_holder_day_data_vid_seq owned by %%SCHEMA%%.sushi_holder_day_data.vid;
+alter table only %%SCHEMA%%.sushi_holder_day_data alter column vid SET DEFAULT nextval('%%SCHEMA%%.sushi_holder_day_data_vid_seq'::regclass);
+`
+
+	ddl.createTables["sushi_delegate"] = `
+create table if not exists %%SCHEMA%%.sushi_delegate
+(
+	id text not null,
+
+	"votes" numeric not null,
+
+	"last_updated_block" numeric not null,
+
+	vid bigserial not null constraint sushi_delegate_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.sushi_delegate owner to graph;
+alter sequence %%SCHEMA%%.sushi_delegate_vid_seq owned by %%SCHEMA%%.sushi_delegate.vid;
+alter table only %%SCHEMA%%.sushi_delegate alter column vid SET DEFAULT nextval('%%SCHEMA%%.sushi_delegate_vid_seq'::regclass);
+`
+
+	ddl.createTables["sushi_vote_change"] = `
+create table if not exists %%SCHEMA%%.sushi_vote_change
+(
+	id text not null,
+
+	"delegate" text not null,
+
+	"previous_votes" numeric not null,
+
+	"new_votes" numeric not null,
+
+	"block" numeric not null,
+
+	"timestamp" numeric not null,
+
+	"transaction" text not null,
+
+	vid bigserial not null constraint sushi_vote_change_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.sushi_vote_change owner to graph;
+alter sequence %%SCHEMA%%.sushi_vote_change_vid_seq owned by %%SCHEMA%%.sushi_vote_change.vid;
+alter table only %%SCHEMA%%.sushi_vote_change alter column vid SET DEFAULT nextval('%%SCHEMA%%.sushi_vote_change_vid_seq'::regclass);
+`
+
+	ddl.createTables["master_chef_pool"] = `
+create table if not exists %%SCHEMA%%.master_chef_pool
+(
+	id text not null,
+
+	"pair" text,
+
+	"last_updated_block" numeric not null,
+
+	vid bigserial not null constraint master_chef_pool_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.master_chef_pool owner to graph;
+alter sequence %%SCHEMA%%.master_chef_pool_vid_seq owned by %%SCHEMA%%.master_chef_pool.vid;
+alter table only %%SCHEMA%%.master_chef_pool alter column vid SET DEFAULT nextval('%%SCHEMA%%.master_chef_pool_vid_seq'::regclass);
+`
+
+	ddl.createTables["master_chef_stake"] = `
+create table if not exists %%SCHEMA%%.master_chef_stake
+(
+	id text not null,
+
+	"pool" text not null,
+
+	"user" text not null,
+
+	"amount" numeric not null,
+
+	"pair" text,
+
+	"liquidity_position" text,
+
+	"last_updated_block" numeric not null,
+
+	vid bigserial not null constraint master_chef_stake_pkey primary key,
+	block_range int4range not null,
+	_updated_block_number numeric not null
+);
+
+alter table %%SCHEMA%%.master_chef_stake owner to graph;
+alter sequence %%SCHEMA%%.master_chef_stake_vid_seq owned by %%SCHEMA%%.master_chef_stake.vid;
+alter table only %%SCHEMA%%.master_chef_stake alter column vid SET DEFAULT nextval('%%SCHEMA%%.master_chef_stake_vid_seq'::regclass);
+`
+
+	ddl.indexes["user"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists user_block_range_closed on %%SCHEMA%%.user (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.user_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists user_id on %%SCHEMA%%.user (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.user_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists user_updated_block_number on %%SCHEMA%%.user (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.user_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists user_id_block_range_fake_excl on %%SCHEMA%%.user using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.user_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists user_usd_swapped on %%SCHEMA%%.user using btree ("usd_swapped");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.user_usd_swapped;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists user_swap_count on %%SCHEMA%%.user using btree ("swap_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.user_swap_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists user_active_position_count on %%SCHEMA%%.user using btree ("active_position_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.user_active_position_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists user_first_seen_block on %%SCHEMA%%.user using btree ("first_seen_block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.user_first_seen_block;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists user_last_active_block on %%SCHEMA%%.user using btree ("last_active_block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.user_last_active_block;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["bundle"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists bundle_block_range_closed on %%SCHEMA%%.bundle (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists bundle_id on %%SCHEMA%%.bundle (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists bundle_updated_block_number on %%SCHEMA%%.bundle (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists bundle_id_block_range_fake_excl on %%SCHEMA%%.bundle using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists bundle_eth_price on %%SCHEMA%%.bundle using btree ("eth_price");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_eth_price;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists bundle_eth_price_block on %%SCHEMA%%.bundle using btree ("eth_price_block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_eth_price_block;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists bundle_pending_eth_price on %%SCHEMA%%.bundle using btree ("pending_eth_price");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_pending_eth_price;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists bundle_pending_eth_price_block on %%SCHEMA%%.bundle using btree ("pending_eth_price_block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.bundle_pending_eth_price_block;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["eth_price_hour_data"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_hour_data_block_range_closed on %%SCHEMA%%.eth_price_hour_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_hour_data_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_hour_data_id on %%SCHEMA%%.eth_price_hour_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_hour_data_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_hour_data_updated_block_number on %%SCHEMA%%.eth_price_hour_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_hour_data_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_hour_data_id_block_range_fake_excl on %%SCHEMA%%.eth_price_hour_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_hour_data_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_hour_data_date on %%SCHEMA%%.eth_price_hour_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_hour_data_date;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_hour_data_open on %%SCHEMA%%.eth_price_hour_data using btree ("open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_hour_data_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_hour_data_high on %%SCHEMA%%.eth_price_hour_data using btree ("high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_hour_data_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_hour_data_low on %%SCHEMA%%.eth_price_hour_data using btree ("low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_hour_data_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_hour_data_close on %%SCHEMA%%.eth_price_hour_data using btree ("close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_hour_data_close;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["eth_price_day_data"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_day_data_block_range_closed on %%SCHEMA%%.eth_price_day_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_day_data_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_day_data_id on %%SCHEMA%%.eth_price_day_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_day_data_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_day_data_updated_block_number on %%SCHEMA%%.eth_price_day_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_day_data_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_day_data_id_block_range_fake_excl on %%SCHEMA%%.eth_price_day_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_day_data_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_day_data_date on %%SCHEMA%%.eth_price_day_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_day_data_date;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_day_data_open on %%SCHEMA%%.eth_price_day_data using btree ("open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_day_data_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_day_data_high on %%SCHEMA%%.eth_price_day_data using btree ("high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_day_data_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_day_data_low on %%SCHEMA%%.eth_price_day_data using btree ("low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_day_data_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_day_data_close on %%SCHEMA%%.eth_price_day_data using btree ("close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_day_data_close;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["eth_price_source_data"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_source_data_block_range_closed on %%SCHEMA%%.eth_price_source_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_source_data_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_source_data_id on %%SCHEMA%%.eth_price_source_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_source_data_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_source_data_updated_block_number on %%SCHEMA%%.eth_price_source_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_source_data_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_source_data_id_block_range_fake_excl on %%SCHEMA%%.eth_price_source_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_source_data_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_source_data_hour_data on %%SCHEMA%%.eth_price_source_data using gist ("hour_data", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_source_data_hour_data;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_source_data_day_data on %%SCHEMA%%.eth_price_source_data using gist ("day_data", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_source_data_day_data;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_source_data_pair on %%SCHEMA%%.eth_price_source_data using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_source_data_pair;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_source_data_price on %%SCHEMA%%.eth_price_source_data using btree ("price");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_source_data_price;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_source_data_liquidity_eth on %%SCHEMA%%.eth_price_source_data using btree ("liquidity_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_source_data_liquidity_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists eth_price_source_data_used on %%SCHEMA%%.eth_price_source_data using btree ("used");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.eth_price_source_data_used;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["price_anomaly"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_block_range_closed on %%SCHEMA%%.price_anomaly (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_id on %%SCHEMA%%.price_anomaly (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_updated_block_number on %%SCHEMA%%.price_anomaly (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_id_block_range_fake_excl on %%SCHEMA%%.price_anomaly using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_block on %%SCHEMA%%.price_anomaly using btree ("block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_block;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_timestamp on %%SCHEMA%%.price_anomaly using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_timestamp;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_transaction on %%SCHEMA%%.price_anomaly ("left"("transaction", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_transaction;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_pair on %%SCHEMA%%.price_anomaly using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_pair;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_old_price on %%SCHEMA%%.price_anomaly using btree ("old_price");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_old_price;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_new_price on %%SCHEMA%%.price_anomaly using btree ("new_price");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_new_price;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists price_anomaly_reason on %%SCHEMA%%.price_anomaly ("left"("reason", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.price_anomaly_reason;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["factory"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_block_range_closed on %%SCHEMA%%.factory (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_id on %%SCHEMA%%.factory (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_updated_block_number on %%SCHEMA%%.factory (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_id_block_range_fake_excl on %%SCHEMA%%.factory using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_pair_count on %%SCHEMA%%.factory using btree ("pair_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_pair_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_volume_usd on %%SCHEMA%%.factory using btree ("volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_volume_eth on %%SCHEMA%%.factory using btree ("volume_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_volume_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_untracked_volume_usd on %%SCHEMA%%.factory using btree ("untracked_volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_untracked_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_fees_usd on %%SCHEMA%%.factory using btree ("fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_lp_fees_usd on %%SCHEMA%%.factory using btree ("lp_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_lp_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_protocol_fees_usd on %%SCHEMA%%.factory using btree ("protocol_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_protocol_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_liquidity_usd on %%SCHEMA%%.factory using btree ("liquidity_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_liquidity_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_liquidity_eth on %%SCHEMA%%.factory using btree ("liquidity_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_liquidity_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_tx_count on %%SCHEMA%%.factory using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_tx_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_token_count on %%SCHEMA%%.factory using btree ("token_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_token_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists factory_user_count on %%SCHEMA%%.factory using btree ("user_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.factory_user_count;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["hour_data"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_block_range_closed on %%SCHEMA%%.hour_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_id on %%SCHEMA%%.hour_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_updated_block_number on %%SCHEMA%%.hour_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_id_block_range_fake_excl on %%SCHEMA%%.hour_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_date on %%SCHEMA%%.hour_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_date;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_factory on %%SCHEMA%%.hour_data using gist ("factory", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_factory;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_volume_eth on %%SCHEMA%%.hour_data using btree ("volume_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_volume_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_volume_usd on %%SCHEMA%%.hour_data using btree ("volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_untracked_volume on %%SCHEMA%%.hour_data using btree ("untracked_volume");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_untracked_volume;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_liquidity_eth on %%SCHEMA%%.hour_data using btree ("liquidity_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_liquidity_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_liquidity_usd on %%SCHEMA%%.hour_data using btree ("liquidity_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_liquidity_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists hour_data_tx_count on %%SCHEMA%%.hour_data using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_tx_count;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["day_data"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_block_range_closed on %%SCHEMA%%.day_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_id on %%SCHEMA%%.day_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_updated_block_number on %%SCHEMA%%.day_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_id_block_range_fake_excl on %%SCHEMA%%.day_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_date on %%SCHEMA%%.day_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_date;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_factory on %%SCHEMA%%.day_data using gist ("factory", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_factory;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_volume_eth on %%SCHEMA%%.day_data using btree ("volume_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_volume_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_volume_usd on %%SCHEMA%%.day_data using btree ("volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_untracked_volume on %%SCHEMA%%.day_data using btree ("untracked_volume");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_untracked_volume;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_fees_usd on %%SCHEMA%%.day_data using btree ("fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_lp_fees_usd on %%SCHEMA%%.day_data using btree ("lp_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_lp_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_protocol_fees_usd on %%SCHEMA%%.day_data using btree ("protocol_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_protocol_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_liquidity_eth on %%SCHEMA%%.day_data using btree ("liquidity_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_liquidity_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_liquidity_usd on %%SCHEMA%%.day_data using btree ("liquidity_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_liquidity_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists day_data_tx_count on %%SCHEMA%%.day_data using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_tx_count;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["token"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_block_range_closed on %%SCHEMA%%.token (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_id on %%SCHEMA%%.token (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_updated_block_number on %%SCHEMA%%.token (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_id_block_range_fake_excl on %%SCHEMA%%.token using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_factory on %%SCHEMA%%.token using gist ("factory", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_factory;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_symbol on %%SCHEMA%%.token ("left"("symbol", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_symbol;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_name on %%SCHEMA%%.token ("left"("name", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_name;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_decimals on %%SCHEMA%%.token using btree ("decimals");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_decimals;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_decimals_valid on %%SCHEMA%%.token using btree ("decimals_valid");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_decimals_valid;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_total_supply on %%SCHEMA%%.token using btree ("total_supply");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_total_supply;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_volume on %%SCHEMA%%.token using btree ("volume");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_volume;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_untracked_volume_usd on %%SCHEMA%%.token using btree ("untracked_volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_untracked_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_tx_count on %%SCHEMA%%.token using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_tx_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_liquidity on %%SCHEMA%%.token using btree ("liquidity");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_liquidity;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_derived_eth on %%SCHEMA%%.token using btree ("derived_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_derived_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_derived_eth_route on %%SCHEMA%%.token using gin (derived_eth_route);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_derived_eth_route;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_derived_eth_hops on %%SCHEMA%%.token using btree ("derived_eth_hops");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_derived_eth_hops;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_whitelist_pairs on %%SCHEMA%%.token using gin (whitelist_pairs);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_whitelist_pairs;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["token_hour_data"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_block_range_closed on %%SCHEMA%%.token_hour_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_id on %%SCHEMA%%.token_hour_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_updated_block_number on %%SCHEMA%%.token_hour_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_id_block_range_fake_excl on %%SCHEMA%%.token_hour_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_date on %%SCHEMA%%.token_hour_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_date;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_token on %%SCHEMA%%.token_hour_data using gist ("token", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_token;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_volume on %%SCHEMA%%.token_hour_data using btree ("volume");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_volume;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_volume_eth on %%SCHEMA%%.token_hour_data using btree ("volume_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_volume_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_volume_usd on %%SCHEMA%%.token_hour_data using btree ("volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_tx_count on %%SCHEMA%%.token_hour_data using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_tx_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_liquidity on %%SCHEMA%%.token_hour_data using btree ("liquidity");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_liquidity;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_liquidity_eth on %%SCHEMA%%.token_hour_data using btree ("liquidity_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_liquidity_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_liquidity_usd on %%SCHEMA%%.token_hour_data using btree ("liquidity_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_liquidity_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_hour_data_price_usd on %%SCHEMA%%.token_hour_data using btree ("price_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_price_usd;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["token_day_data"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_block_range_closed on %%SCHEMA%%.token_day_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_id on %%SCHEMA%%.token_day_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_updated_block_number on %%SCHEMA%%.token_day_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_id_block_range_fake_excl on %%SCHEMA%%.token_day_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_date on %%SCHEMA%%.token_day_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_date;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_token on %%SCHEMA%%.token_day_data using gist ("token", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_token;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_volume on %%SCHEMA%%.token_day_data using btree ("volume");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_volume;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_volume_eth on %%SCHEMA%%.token_day_data using btree ("volume_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_volume_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_volume_usd on %%SCHEMA%%.token_day_data using btree ("volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_tx_count on %%SCHEMA%%.token_day_data using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_tx_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_liquidity on %%SCHEMA%%.token_day_data using btree ("liquidity");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_liquidity;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_liquidity_eth on %%SCHEMA%%.token_day_data using btree ("liquidity_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_liquidity_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_liquidity_usd on %%SCHEMA%%.token_day_data using btree ("liquidity_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_liquidity_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_price_usd on %%SCHEMA%%.token_day_data using btree ("price_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_price_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_total_supply on %%SCHEMA%%.token_day_data using btree ("total_supply");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_total_supply;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_day_data_market_cap_usd on %%SCHEMA%%.token_day_data using btree ("market_cap_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_market_cap_usd;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["token_interval_data"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_block_range_closed on %%SCHEMA%%.token_interval_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_id on %%SCHEMA%%.token_interval_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_updated_block_number on %%SCHEMA%%.token_interval_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_id_block_range_fake_excl on %%SCHEMA%%.token_interval_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_interval on %%SCHEMA%%.token_interval_data ("left"("interval", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_interval;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_date on %%SCHEMA%%.token_interval_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_date;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_token on %%SCHEMA%%.token_interval_data using gist ("token", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_token;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_volume on %%SCHEMA%%.token_interval_data using btree ("volume");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_volume;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_volume_eth on %%SCHEMA%%.token_interval_data using btree ("volume_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_volume_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_volume_usd on %%SCHEMA%%.token_interval_data using btree ("volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_tx_count on %%SCHEMA%%.token_interval_data using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_tx_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_liquidity on %%SCHEMA%%.token_interval_data using btree ("liquidity");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_liquidity;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_liquidity_eth on %%SCHEMA%%.token_interval_data using btree ("liquidity_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_liquidity_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_liquidity_usd on %%SCHEMA%%.token_interval_data using btree ("liquidity_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_liquidity_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists token_interval_data_price_usd on %%SCHEMA%%.token_interval_data using btree ("price_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.token_interval_data_price_usd;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["pair"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_block_range_closed on %%SCHEMA%%.pair (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_id on %%SCHEMA%%.pair (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_updated_block_number on %%SCHEMA%%.pair (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_id_block_range_fake_excl on %%SCHEMA%%.pair using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_factory on %%SCHEMA%%.pair using gist ("factory", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_factory;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_name on %%SCHEMA%%.pair ("left"("name", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_name;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_token_0 on %%SCHEMA%%.pair using gist ("token_0", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_token_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_token_1 on %%SCHEMA%%.pair using gist ("token_1", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_token_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_reserve_0 on %%SCHEMA%%.pair using btree ("reserve_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_reserve_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_reserve_1 on %%SCHEMA%%.pair using btree ("reserve_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_reserve_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_total_supply on %%SCHEMA%%.pair using btree ("total_supply");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_total_supply;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_reserve_eth on %%SCHEMA%%.pair using btree ("reserve_eth");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_reserve_eth;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_token_0_price on %%SCHEMA%%.pair using btree ("token_0_price");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_token_0_price;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_token_1_price on %%SCHEMA%%.pair using btree ("token_1_price");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_token_1_price;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_price_0_cumulative_last on %%SCHEMA%%.pair using btree ("price_0_cumulative_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_price_0_cumulative_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_price_1_cumulative_last on %%SCHEMA%%.pair using btree ("price_1_cumulative_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_price_1_cumulative_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_block_timestamp_last on %%SCHEMA%%.pair using btree ("block_timestamp_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_block_timestamp_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_volume_token_0 on %%SCHEMA%%.pair using btree ("volume_token_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_volume_token_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_volume_token_1 on %%SCHEMA%%.pair using btree ("volume_token_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_volume_token_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_untracked_volume_usd on %%SCHEMA%%.pair using btree ("untracked_volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_untracked_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_tx_count on %%SCHEMA%%.pair using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_tx_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_fees_token_0 on %%SCHEMA%%.pair using btree ("fees_token_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_fees_token_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_fees_token_1 on %%SCHEMA%%.pair using btree ("fees_token_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_fees_token_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_fees_usd on %%SCHEMA%%.pair using btree ("fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_lp_fees_usd on %%SCHEMA%%.pair using btree ("lp_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_lp_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_protocol_fees_usd on %%SCHEMA%%.pair using btree ("protocol_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_protocol_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_7_d on %%SCHEMA%%.pair using btree ("apr_7_d");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_7_d;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_window_timestamp on %%SCHEMA%%.pair using btree ("apr_window_timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_window_timestamp;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_lp_fees_usd_0 on %%SCHEMA%%.pair using btree ("apr_lp_fees_usd_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_lp_fees_usd_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_sum_0 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_sum_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_sum_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_samples_0 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_samples_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_samples_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_lp_fees_usd_1 on %%SCHEMA%%.pair using btree ("apr_lp_fees_usd_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_lp_fees_usd_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_sum_1 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_sum_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_sum_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_samples_1 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_samples_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_samples_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_lp_fees_usd_2 on %%SCHEMA%%.pair using btree ("apr_lp_fees_usd_2");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_lp_fees_usd_2;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_sum_2 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_sum_2");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_sum_2;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_samples_2 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_samples_2");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_samples_2;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_lp_fees_usd_3 on %%SCHEMA%%.pair using btree ("apr_lp_fees_usd_3");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_lp_fees_usd_3;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_sum_3 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_sum_3");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_sum_3;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_samples_3 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_samples_3");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_samples_3;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_lp_fees_usd_4 on %%SCHEMA%%.pair using btree ("apr_lp_fees_usd_4");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_lp_fees_usd_4;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_sum_4 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_sum_4");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_sum_4;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_samples_4 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_samples_4");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_samples_4;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_lp_fees_usd_5 on %%SCHEMA%%.pair using btree ("apr_lp_fees_usd_5");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_lp_fees_usd_5;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_sum_5 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_sum_5");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_sum_5;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_samples_5 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_samples_5");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_samples_5;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_lp_fees_usd_6 on %%SCHEMA%%.pair using btree ("apr_lp_fees_usd_6");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_lp_fees_usd_6;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_sum_6 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_sum_6");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_sum_6;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_apr_reserve_usd_samples_6 on %%SCHEMA%%.pair using btree ("apr_reserve_usd_samples_6");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_apr_reserve_usd_samples_6;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_liquidity_provider_count on %%SCHEMA%%.pair using btree ("liquidity_provider_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_liquidity_provider_count;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_timestamp on %%SCHEMA%%.pair using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_timestamp;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_block on %%SCHEMA%%.pair using btree ("block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_block;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["pair_hour_data"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_block_range_closed on %%SCHEMA%%.pair_hour_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_id on %%SCHEMA%%.pair_hour_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_updated_block_number on %%SCHEMA%%.pair_hour_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_updated_block_number;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_id_block_range_fake_excl on %%SCHEMA%%.pair_hour_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_id_block_range_fake_excl;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_date on %%SCHEMA%%.pair_hour_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_date;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_pair on %%SCHEMA%%.pair_hour_data using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_pair;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_reserve_0 on %%SCHEMA%%.pair_hour_data using btree ("reserve_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_reserve_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_reserve_1 on %%SCHEMA%%.pair_hour_data using btree ("reserve_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_reserve_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_reserve_usd on %%SCHEMA%%.pair_hour_data using btree ("reserve_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_reserve_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_price_0_cumulative_last on %%SCHEMA%%.pair_hour_data using btree ("price_0_cumulative_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_price_0_cumulative_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_price_1_cumulative_last on %%SCHEMA%%.pair_hour_data using btree ("price_1_cumulative_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_price_1_cumulative_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_block_timestamp_last on %%SCHEMA%%.pair_hour_data using btree ("block_timestamp_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_block_timestamp_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_0_price_open on %%SCHEMA%%.pair_hour_data using btree ("token_0_price_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_0_price_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_0_price_high on %%SCHEMA%%.pair_hour_data using btree ("token_0_price_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_0_price_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_0_price_low on %%SCHEMA%%.pair_hour_data using btree ("token_0_price_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_0_price_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_0_price_close on %%SCHEMA%%.pair_hour_data using btree ("token_0_price_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_0_price_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_1_price_open on %%SCHEMA%%.pair_hour_data using btree ("token_1_price_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_1_price_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_1_price_high on %%SCHEMA%%.pair_hour_data using btree ("token_1_price_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_1_price_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_1_price_low on %%SCHEMA%%.pair_hour_data using btree ("token_1_price_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_1_price_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_1_price_close on %%SCHEMA%%.pair_hour_data using btree ("token_1_price_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_1_price_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_0_price_usd_open on %%SCHEMA%%.pair_hour_data using btree ("token_0_price_usd_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_0_price_usd_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_0_price_usd_high on %%SCHEMA%%.pair_hour_data using btree ("token_0_price_usd_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_0_price_usd_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_0_price_usd_low on %%SCHEMA%%.pair_hour_data using btree ("token_0_price_usd_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_0_price_usd_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_0_price_usd_close on %%SCHEMA%%.pair_hour_data using btree ("token_0_price_usd_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_0_price_usd_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_1_price_usd_open on %%SCHEMA%%.pair_hour_data using btree ("token_1_price_usd_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_1_price_usd_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_1_price_usd_high on %%SCHEMA%%.pair_hour_data using btree ("token_1_price_usd_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_1_price_usd_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_1_price_usd_low on %%SCHEMA%%.pair_hour_data using btree ("token_1_price_usd_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_1_price_usd_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_token_1_price_usd_close on %%SCHEMA%%.pair_hour_data using btree ("token_1_price_usd_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_token_1_price_usd_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_volume_token_0 on %%SCHEMA%%.pair_hour_data using btree ("volume_token_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_volume_token_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_volume_token_1 on %%SCHEMA%%.pair_hour_data using btree ("volume_token_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_volume_token_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_volume_usd on %%SCHEMA%%.pair_hour_data using btree ("volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_fees_token_0 on %%SCHEMA%%.pair_hour_data using btree ("fees_token_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_fees_token_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_fees_token_1 on %%SCHEMA%%.pair_hour_data using btree ("fees_token_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_fees_token_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_fees_usd on %%SCHEMA%%.pair_hour_data using btree ("fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_lp_fees_usd on %%SCHEMA%%.pair_hour_data using btree ("lp_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_lp_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_protocol_fees_usd on %%SCHEMA%%.pair_hour_data using btree ("protocol_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_protocol_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_reserve_usd_sum on %%SCHEMA%%.pair_hour_data using btree ("reserve_usd_sum");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_reserve_usd_sum;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_reserve_usd_samples on %%SCHEMA%%.pair_hour_data using btree ("reserve_usd_samples");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_reserve_usd_samples;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_apr on %%SCHEMA%%.pair_hour_data using btree ("apr");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_apr;`,
+		})
 
-	ddl.indexes["factory"] = func() []*index {
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_apy on %%SCHEMA%%.pair_hour_data using btree ("apy");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_apy;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_hour_data_tx_count on %%SCHEMA%%.pair_hour_data using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_tx_count;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["pair_day_data"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_block_range_closed on %%SCHEMA%%.factory (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_block_range_closed;`,
+			createStatement: `create index if not exists pair_day_data_block_range_closed on %%SCHEMA%%.pair_day_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_block_range_closed;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_id on %%SCHEMA%%.factory (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_id;`,
+			createStatement: `create index if not exists pair_day_data_id on %%SCHEMA%%.pair_day_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_id;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_updated_block_number on %%SCHEMA%%.factory (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_updated_block_number;`,
+			createStatement: `create index if not exists pair_day_data_updated_block_number on %%SCHEMA%%.pair_day_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_id_block_range_fake_excl on %%SCHEMA%%.factory using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists pair_day_data_id_block_range_fake_excl on %%SCHEMA%%.pair_day_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_pair_count on %%SCHEMA%%.factory using btree ("pair_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_pair_count;`,
+			createStatement: `create index if not exists pair_day_data_date on %%SCHEMA%%.pair_day_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_date;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_volume_usd on %%SCHEMA%%.factory using btree ("volume_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_volume_usd;`,
+			createStatement: `create index if not exists pair_day_data_pair on %%SCHEMA%%.pair_day_data using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_pair;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_volume_eth on %%SCHEMA%%.factory using btree ("volume_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_volume_eth;`,
+			createStatement: `create index if not exists pair_day_data_token_0 on %%SCHEMA%%.pair_day_data using gist ("token_0", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_0;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_untracked_volume_usd on %%SCHEMA%%.factory using btree ("untracked_volume_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_untracked_volume_usd;`,
+			createStatement: `create index if not exists pair_day_data_token_1 on %%SCHEMA%%.pair_day_data using gist ("token_1", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_1;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_liquidity_usd on %%SCHEMA%%.factory using btree ("liquidity_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_liquidity_usd;`,
+			createStatement: `create index if not exists pair_day_data_reserve_0 on %%SCHEMA%%.pair_day_data using btree ("reserve_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_reserve_0;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_liquidity_eth on %%SCHEMA%%.factory using btree ("liquidity_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_liquidity_eth;`,
+			createStatement: `create index if not exists pair_day_data_reserve_1 on %%SCHEMA%%.pair_day_data using btree ("reserve_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_reserve_1;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_tx_count on %%SCHEMA%%.factory using btree ("tx_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_tx_count;`,
+			createStatement: `create index if not exists pair_day_data_total_supply on %%SCHEMA%%.pair_day_data using btree ("total_supply");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_total_supply;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_token_count on %%SCHEMA%%.factory using btree ("token_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_token_count;`,
+			createStatement: `create index if not exists pair_day_data_reserve_usd on %%SCHEMA%%.pair_day_data using btree ("reserve_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_reserve_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists factory_user_count on %%SCHEMA%%.factory using btree ("user_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.factory_user_count;`,
+			createStatement: `create index if not exists pair_day_data_price_0_cumulative_last on %%SCHEMA%%.pair_day_data using btree ("price_0_cumulative_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_price_0_cumulative_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_price_1_cumulative_last on %%SCHEMA%%.pair_day_data using btree ("price_1_cumulative_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_price_1_cumulative_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_block_timestamp_last on %%SCHEMA%%.pair_day_data using btree ("block_timestamp_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_block_timestamp_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_0_price_open on %%SCHEMA%%.pair_day_data using btree ("token_0_price_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_0_price_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_0_price_high on %%SCHEMA%%.pair_day_data using btree ("token_0_price_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_0_price_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_0_price_low on %%SCHEMA%%.pair_day_data using btree ("token_0_price_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_0_price_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_0_price_close on %%SCHEMA%%.pair_day_data using btree ("token_0_price_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_0_price_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_1_price_open on %%SCHEMA%%.pair_day_data using btree ("token_1_price_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_1_price_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_1_price_high on %%SCHEMA%%.pair_day_data using btree ("token_1_price_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_1_price_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_1_price_low on %%SCHEMA%%.pair_day_data using btree ("token_1_price_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_1_price_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_1_price_close on %%SCHEMA%%.pair_day_data using btree ("token_1_price_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_1_price_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_0_price_usd_open on %%SCHEMA%%.pair_day_data using btree ("token_0_price_usd_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_0_price_usd_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_0_price_usd_high on %%SCHEMA%%.pair_day_data using btree ("token_0_price_usd_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_0_price_usd_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_0_price_usd_low on %%SCHEMA%%.pair_day_data using btree ("token_0_price_usd_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_0_price_usd_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_0_price_usd_close on %%SCHEMA%%.pair_day_data using btree ("token_0_price_usd_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_0_price_usd_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_1_price_usd_open on %%SCHEMA%%.pair_day_data using btree ("token_1_price_usd_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_1_price_usd_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_1_price_usd_high on %%SCHEMA%%.pair_day_data using btree ("token_1_price_usd_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_1_price_usd_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_1_price_usd_low on %%SCHEMA%%.pair_day_data using btree ("token_1_price_usd_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_1_price_usd_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_token_1_price_usd_close on %%SCHEMA%%.pair_day_data using btree ("token_1_price_usd_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_1_price_usd_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_volume_token_0 on %%SCHEMA%%.pair_day_data using btree ("volume_token_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_volume_token_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_volume_token_1 on %%SCHEMA%%.pair_day_data using btree ("volume_token_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_volume_token_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_volume_usd on %%SCHEMA%%.pair_day_data using btree ("volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_volume_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_fees_token_0 on %%SCHEMA%%.pair_day_data using btree ("fees_token_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_fees_token_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_fees_token_1 on %%SCHEMA%%.pair_day_data using btree ("fees_token_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_fees_token_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_fees_usd on %%SCHEMA%%.pair_day_data using btree ("fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_lp_fees_usd on %%SCHEMA%%.pair_day_data using btree ("lp_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_lp_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_protocol_fees_usd on %%SCHEMA%%.pair_day_data using btree ("protocol_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_protocol_fees_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_reserve_usd_sum on %%SCHEMA%%.pair_day_data using btree ("reserve_usd_sum");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_reserve_usd_sum;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_reserve_usd_samples on %%SCHEMA%%.pair_day_data using btree ("reserve_usd_samples");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_reserve_usd_samples;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_apr on %%SCHEMA%%.pair_day_data using btree ("apr");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_apr;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_apy on %%SCHEMA%%.pair_day_data using btree ("apy");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_apy;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_day_data_tx_count on %%SCHEMA%%.pair_day_data using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_tx_count;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["hour_data"] = func() []*index {
+	ddl.indexes["pair_interval_data"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_block_range_closed on %%SCHEMA%%.hour_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_block_range_closed;`,
+			createStatement: `create index if not exists pair_interval_data_block_range_closed on %%SCHEMA%%.pair_interval_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_block_range_closed;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_id on %%SCHEMA%%.hour_data (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_id;`,
+			createStatement: `create index if not exists pair_interval_data_id on %%SCHEMA%%.pair_interval_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_id;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_updated_block_number on %%SCHEMA%%.hour_data (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_updated_block_number;`,
+			createStatement: `create index if not exists pair_interval_data_updated_block_number on %%SCHEMA%%.pair_interval_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_id_block_range_fake_excl on %%SCHEMA%%.hour_data using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists pair_interval_data_id_block_range_fake_excl on %%SCHEMA%%.pair_interval_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_date on %%SCHEMA%%.hour_data using btree ("date");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_date;`,
+			createStatement: `create index if not exists pair_interval_data_interval on %%SCHEMA%%.pair_interval_data ("left"("interval", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_interval;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_factory on %%SCHEMA%%.hour_data using gist ("factory", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_factory;`,
+			createStatement: `create index if not exists pair_interval_data_date on %%SCHEMA%%.pair_interval_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_date;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_pair on %%SCHEMA%%.pair_interval_data using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_pair;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_reserve_0 on %%SCHEMA%%.pair_interval_data using btree ("reserve_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_reserve_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_reserve_1 on %%SCHEMA%%.pair_interval_data using btree ("reserve_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_reserve_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_reserve_usd on %%SCHEMA%%.pair_interval_data using btree ("reserve_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_reserve_usd;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_price_0_cumulative_last on %%SCHEMA%%.pair_interval_data using btree ("price_0_cumulative_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_price_0_cumulative_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_price_1_cumulative_last on %%SCHEMA%%.pair_interval_data using btree ("price_1_cumulative_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_price_1_cumulative_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_block_timestamp_last on %%SCHEMA%%.pair_interval_data using btree ("block_timestamp_last");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_block_timestamp_last;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_0_price_open on %%SCHEMA%%.pair_interval_data using btree ("token_0_price_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_0_price_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_0_price_high on %%SCHEMA%%.pair_interval_data using btree ("token_0_price_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_0_price_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_0_price_low on %%SCHEMA%%.pair_interval_data using btree ("token_0_price_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_0_price_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_0_price_close on %%SCHEMA%%.pair_interval_data using btree ("token_0_price_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_0_price_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_1_price_open on %%SCHEMA%%.pair_interval_data using btree ("token_1_price_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_1_price_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_1_price_high on %%SCHEMA%%.pair_interval_data using btree ("token_1_price_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_1_price_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_1_price_low on %%SCHEMA%%.pair_interval_data using btree ("token_1_price_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_1_price_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_1_price_close on %%SCHEMA%%.pair_interval_data using btree ("token_1_price_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_1_price_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_0_price_usd_open on %%SCHEMA%%.pair_interval_data using btree ("token_0_price_usd_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_0_price_usd_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_0_price_usd_high on %%SCHEMA%%.pair_interval_data using btree ("token_0_price_usd_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_0_price_usd_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_0_price_usd_low on %%SCHEMA%%.pair_interval_data using btree ("token_0_price_usd_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_0_price_usd_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_0_price_usd_close on %%SCHEMA%%.pair_interval_data using btree ("token_0_price_usd_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_0_price_usd_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_1_price_usd_open on %%SCHEMA%%.pair_interval_data using btree ("token_1_price_usd_open");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_1_price_usd_open;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_1_price_usd_high on %%SCHEMA%%.pair_interval_data using btree ("token_1_price_usd_high");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_1_price_usd_high;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_1_price_usd_low on %%SCHEMA%%.pair_interval_data using btree ("token_1_price_usd_low");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_1_price_usd_low;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_token_1_price_usd_close on %%SCHEMA%%.pair_interval_data using btree ("token_1_price_usd_close");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_token_1_price_usd_close;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_volume_token_0 on %%SCHEMA%%.pair_interval_data using btree ("volume_token_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_volume_token_0;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_volume_token_1 on %%SCHEMA%%.pair_interval_data using btree ("volume_token_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_volume_token_1;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists pair_interval_data_volume_usd on %%SCHEMA%%.pair_interval_data using btree ("volume_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_volume_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_volume_eth on %%SCHEMA%%.hour_data using btree ("volume_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_volume_eth;`,
+			createStatement: `create index if not exists pair_interval_data_fees_token_0 on %%SCHEMA%%.pair_interval_data using btree ("fees_token_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_fees_token_0;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_volume_usd on %%SCHEMA%%.hour_data using btree ("volume_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_volume_usd;`,
+			createStatement: `create index if not exists pair_interval_data_fees_token_1 on %%SCHEMA%%.pair_interval_data using btree ("fees_token_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_fees_token_1;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_untracked_volume on %%SCHEMA%%.hour_data using btree ("untracked_volume");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_untracked_volume;`,
+			createStatement: `create index if not exists pair_interval_data_fees_usd on %%SCHEMA%%.pair_interval_data using btree ("fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_fees_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_liquidity_eth on %%SCHEMA%%.hour_data using btree ("liquidity_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_liquidity_eth;`,
+			createStatement: `create index if not exists pair_interval_data_lp_fees_usd on %%SCHEMA%%.pair_interval_data using btree ("lp_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_lp_fees_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_liquidity_usd on %%SCHEMA%%.hour_data using btree ("liquidity_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_liquidity_usd;`,
+			createStatement: `create index if not exists pair_interval_data_protocol_fees_usd on %%SCHEMA%%.pair_interval_data using btree ("protocol_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_protocol_fees_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists hour_data_tx_count on %%SCHEMA%%.hour_data using btree ("tx_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.hour_data_tx_count;`,
+			createStatement: `create index if not exists pair_interval_data_tx_count on %%SCHEMA%%.pair_interval_data using btree ("tx_count");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.pair_interval_data_tx_count;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["day_data"] = func() []*index {
+	ddl.indexes["liquidity_position"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_block_range_closed on %%SCHEMA%%.day_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_block_range_closed;`,
+			createStatement: `create index if not exists liquidity_position_block_range_closed on %%SCHEMA%%.liquidity_position (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_block_range_closed;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_id on %%SCHEMA%%.day_data (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_id;`,
+			createStatement: `create index if not exists liquidity_position_id on %%SCHEMA%%.liquidity_position (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_id;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_updated_block_number on %%SCHEMA%%.day_data (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_updated_block_number;`,
+			createStatement: `create index if not exists liquidity_position_updated_block_number on %%SCHEMA%%.liquidity_position (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_id_block_range_fake_excl on %%SCHEMA%%.day_data using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists liquidity_position_id_block_range_fake_excl on %%SCHEMA%%.liquidity_position using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_date on %%SCHEMA%%.day_data using btree ("date");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_date;`,
+			createStatement: `create index if not exists liquidity_position_user on %%SCHEMA%%.liquidity_position using gist ("user", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_user;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_factory on %%SCHEMA%%.day_data using gist ("factory", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_factory;`,
+			createStatement: `create index if not exists liquidity_position_pair on %%SCHEMA%%.liquidity_position using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_pair;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_volume_eth on %%SCHEMA%%.day_data using btree ("volume_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_volume_eth;`,
+			createStatement: `create index if not exists liquidity_position_liquidity_token_balance on %%SCHEMA%%.liquidity_position using btree ("liquidity_token_balance");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_liquidity_token_balance;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_volume_usd on %%SCHEMA%%.day_data using btree ("volume_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_volume_usd;`,
+			createStatement: `create index if not exists liquidity_position_last_snapshot on %%SCHEMA%%.liquidity_position using gist ("last_snapshot", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_last_snapshot;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_untracked_volume on %%SCHEMA%%.day_data using btree ("untracked_volume");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_untracked_volume;`,
+			createStatement: `create index if not exists liquidity_position_staked_liquidity_token_balance on %%SCHEMA%%.liquidity_position using btree ("staked_liquidity_token_balance");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_staked_liquidity_token_balance;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_liquidity_eth on %%SCHEMA%%.day_data using btree ("liquidity_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_liquidity_eth;`,
+			createStatement: `create index if not exists liquidity_position_total_liquidity_token_balance on %%SCHEMA%%.liquidity_position using btree ("total_liquidity_token_balance");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_total_liquidity_token_balance;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_liquidity_usd on %%SCHEMA%%.day_data using btree ("liquidity_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_liquidity_usd;`,
+			createStatement: `create index if not exists liquidity_position_block on %%SCHEMA%%.liquidity_position using btree ("block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_block;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists day_data_tx_count on %%SCHEMA%%.day_data using btree ("tx_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.day_data_tx_count;`,
+			createStatement: `create index if not exists liquidity_position_timestamp on %%SCHEMA%%.liquidity_position using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_timestamp;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["token"] = func() []*index {
+	ddl.indexes["lp_allowance"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_block_range_closed on %%SCHEMA%%.token (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_block_range_closed;`,
-		})
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_id on %%SCHEMA%%.token (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_id;`,
-		})
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_updated_block_number on %%SCHEMA%%.token (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_updated_block_number;`,
-		})
-
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_id_block_range_fake_excl on %%SCHEMA%%.token using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_id_block_range_fake_excl;`,
-		})
-
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_factory on %%SCHEMA%%.token using gist ("factory", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_factory;`,
+			createStatement: `create index if not exists lp_allowance_block_range_closed on %%SCHEMA%%.lp_allowance (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_block_range_closed;`,
 		})
-
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_symbol on %%SCHEMA%%.token ("left"("symbol", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_symbol;`,
+			createStatement: `create index if not exists lp_allowance_id on %%SCHEMA%%.lp_allowance (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_id;`,
 		})
-
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_name on %%SCHEMA%%.token ("left"("name", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_name;`,
+			createStatement: `create index if not exists lp_allowance_updated_block_number on %%SCHEMA%%.lp_allowance (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_decimals on %%SCHEMA%%.token using btree ("decimals");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_decimals;`,
+			createStatement: `create index if not exists lp_allowance_id_block_range_fake_excl on %%SCHEMA%%.lp_allowance using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_total_supply on %%SCHEMA%%.token using btree ("total_supply");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_total_supply;`,
+			createStatement: `create index if not exists lp_allowance_owner on %%SCHEMA%%.lp_allowance ("left"("owner", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_owner;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_volume on %%SCHEMA%%.token using btree ("volume");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_volume;`,
+			createStatement: `create index if not exists lp_allowance_spender on %%SCHEMA%%.lp_allowance ("left"("spender", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_spender;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_untracked_volume_usd on %%SCHEMA%%.token using btree ("untracked_volume_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_untracked_volume_usd;`,
+			createStatement: `create index if not exists lp_allowance_pair on %%SCHEMA%%.lp_allowance using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_pair;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_tx_count on %%SCHEMA%%.token using btree ("tx_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_tx_count;`,
+			createStatement: `create index if not exists lp_allowance_amount on %%SCHEMA%%.lp_allowance using btree ("amount");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_amount;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_liquidity on %%SCHEMA%%.token using btree ("liquidity");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_liquidity;`,
+			createStatement: `create index if not exists lp_allowance_unlimited on %%SCHEMA%%.lp_allowance using btree ("unlimited");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_unlimited;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_derived_eth on %%SCHEMA%%.token using btree ("derived_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_derived_eth;`,
+			createStatement: `create index if not exists lp_allowance_last_updated_block on %%SCHEMA%%.lp_allowance using btree ("last_updated_block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_last_updated_block;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_whitelist_pairs on %%SCHEMA%%.token using gin (whitelist_pairs);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_whitelist_pairs;`,
+			createStatement: `create index if not exists lp_allowance_last_updated_timestamp on %%SCHEMA%%.lp_allowance using btree ("last_updated_timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.lp_allowance_last_updated_timestamp;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["token_hour_data"] = func() []*index {
+	ddl.indexes["liquidity_position_snapshot"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_block_range_closed on %%SCHEMA%%.token_hour_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_block_range_closed;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_block_range_closed on %%SCHEMA%%.liquidity_position_snapshot (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_block_range_closed;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_id on %%SCHEMA%%.token_hour_data (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_id;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_id on %%SCHEMA%%.liquidity_position_snapshot (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_id;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_updated_block_number on %%SCHEMA%%.token_hour_data (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_updated_block_number;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_updated_block_number on %%SCHEMA%%.liquidity_position_snapshot (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_id_block_range_fake_excl on %%SCHEMA%%.token_hour_data using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_id_block_range_fake_excl on %%SCHEMA%%.liquidity_position_snapshot using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_date on %%SCHEMA%%.token_hour_data using btree ("date");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_date;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_liquidity_position on %%SCHEMA%%.liquidity_position_snapshot using gist ("liquidity_position", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_liquidity_position;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_token on %%SCHEMA%%.token_hour_data using gist ("token", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_token;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_timestamp on %%SCHEMA%%.liquidity_position_snapshot using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_timestamp;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_volume on %%SCHEMA%%.token_hour_data using btree ("volume");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_volume;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_block on %%SCHEMA%%.liquidity_position_snapshot using btree ("block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_block;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_volume_eth on %%SCHEMA%%.token_hour_data using btree ("volume_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_volume_eth;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_user on %%SCHEMA%%.liquidity_position_snapshot using gist ("user", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_user;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_volume_usd on %%SCHEMA%%.token_hour_data using btree ("volume_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_volume_usd;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_pair on %%SCHEMA%%.liquidity_position_snapshot using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_pair;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_tx_count on %%SCHEMA%%.token_hour_data using btree ("tx_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_tx_count;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_token_0_price_usd on %%SCHEMA%%.liquidity_position_snapshot using btree ("token_0_price_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_token_0_price_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_liquidity on %%SCHEMA%%.token_hour_data using btree ("liquidity");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_liquidity;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_token_1_price_usd on %%SCHEMA%%.liquidity_position_snapshot using btree ("token_1_price_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_token_1_price_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_liquidity_eth on %%SCHEMA%%.token_hour_data using btree ("liquidity_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_liquidity_eth;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_reserve_0 on %%SCHEMA%%.liquidity_position_snapshot using btree ("reserve_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_reserve_0;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_liquidity_usd on %%SCHEMA%%.token_hour_data using btree ("liquidity_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_liquidity_usd;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_reserve_1 on %%SCHEMA%%.liquidity_position_snapshot using btree ("reserve_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_reserve_1;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_hour_data_price_usd on %%SCHEMA%%.token_hour_data using btree ("price_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_hour_data_price_usd;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_reserve_usd on %%SCHEMA%%.liquidity_position_snapshot using btree ("reserve_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_reserve_usd;`,
 		})
 
-		return indexes
-	}()
-
-	ddl.indexes["token_day_data"] = func() []*index {
-		var indexes []*index
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_block_range_closed on %%SCHEMA%%.token_day_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_block_range_closed;`,
-		})
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_id on %%SCHEMA%%.token_day_data (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_id;`,
-		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_updated_block_number on %%SCHEMA%%.token_day_data (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_updated_block_number;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_liquidity_token_total_supply on %%SCHEMA%%.liquidity_position_snapshot using btree ("liquidity_token_total_supply");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_liquidity_token_total_supply;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_id_block_range_fake_excl on %%SCHEMA%%.token_day_data using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_liquidity_token_balance on %%SCHEMA%%.liquidity_position_snapshot using btree ("liquidity_token_balance");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_liquidity_token_balance;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_date on %%SCHEMA%%.token_day_data using btree ("date");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_date;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_staked_liquidity_token_balance on %%SCHEMA%%.liquidity_position_snapshot using btree ("staked_liquidity_token_balance");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_staked_liquidity_token_balance;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_token on %%SCHEMA%%.token_day_data using gist ("token", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_token;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_total_liquidity_token_balance on %%SCHEMA%%.liquidity_position_snapshot using btree ("total_liquidity_token_balance");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_total_liquidity_token_balance;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_volume on %%SCHEMA%%.token_day_data using btree ("volume");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_volume;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_previous_snapshot on %%SCHEMA%%.liquidity_position_snapshot using gist ("previous_snapshot", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_previous_snapshot;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_volume_eth on %%SCHEMA%%.token_day_data using btree ("volume_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_volume_eth;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_token_0_amount on %%SCHEMA%%.liquidity_position_snapshot using btree ("token_0_amount");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_token_0_amount;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_volume_usd on %%SCHEMA%%.token_day_data using btree ("volume_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_volume_usd;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_token_1_amount on %%SCHEMA%%.liquidity_position_snapshot using btree ("token_1_amount");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_token_1_amount;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_tx_count on %%SCHEMA%%.token_day_data using btree ("tx_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_tx_count;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_value_usd on %%SCHEMA%%.liquidity_position_snapshot using btree ("value_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_value_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_liquidity on %%SCHEMA%%.token_day_data using btree ("liquidity");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_liquidity;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_hodl_value_usd on %%SCHEMA%%.liquidity_position_snapshot using btree ("hodl_value_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_hodl_value_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_liquidity_eth on %%SCHEMA%%.token_day_data using btree ("liquidity_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_liquidity_eth;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_pnl_usd on %%SCHEMA%%.liquidity_position_snapshot using btree ("pnl_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_pnl_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_liquidity_usd on %%SCHEMA%%.token_day_data using btree ("liquidity_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_liquidity_usd;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_impermanent_loss on %%SCHEMA%%.liquidity_position_snapshot using btree ("impermanent_loss");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_impermanent_loss;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists token_day_data_price_usd on %%SCHEMA%%.token_day_data using btree ("price_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.token_day_data_price_usd;`,
+			createStatement: `create index if not exists liquidity_position_snapshot_fees_accrued_usd on %%SCHEMA%%.liquidity_position_snapshot using btree ("fees_accrued_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_fees_accrued_usd;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["pair"] = func() []*index {
+	ddl.indexes["transaction"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_block_range_closed on %%SCHEMA%%.pair (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_block_range_closed;`,
+			createStatement: `create index if not exists transaction_block_range_closed on %%SCHEMA%%.transaction (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_block_range_closed;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_id on %%SCHEMA%%.pair (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_id;`,
+			createStatement: `create index if not exists transaction_id on %%SCHEMA%%.transaction (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_id;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_updated_block_number on %%SCHEMA%%.pair (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_updated_block_number;`,
+			createStatement: `create index if not exists transaction_updated_block_number on %%SCHEMA%%.transaction (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_id_block_range_fake_excl on %%SCHEMA%%.pair using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists transaction_id_block_range_fake_excl on %%SCHEMA%%.transaction using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_factory on %%SCHEMA%%.pair using gist ("factory", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_factory;`,
+			createStatement: `create index if not exists transaction_block_number on %%SCHEMA%%.transaction using btree ("block_number");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_name on %%SCHEMA%%.pair ("left"("name", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_name;`,
+			createStatement: `create index if not exists transaction_timestamp on %%SCHEMA%%.transaction using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_timestamp;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_token_0 on %%SCHEMA%%.pair using gist ("token_0", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_token_0;`,
+			createStatement: `create index if not exists transaction_mints on %%SCHEMA%%.transaction using gin (mints);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_mints;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_token_1 on %%SCHEMA%%.pair using gist ("token_1", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_token_1;`,
+			createStatement: `create index if not exists transaction_burns on %%SCHEMA%%.transaction using gin (burns);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_burns;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists transaction_swaps on %%SCHEMA%%.transaction using gin (swaps);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_swaps;`,
+		})
+
+		return indexes
+	}()
+
+	ddl.indexes["mint"] = func() []*index {
+		var indexes []*index
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists mint_block_range_closed on %%SCHEMA%%.mint (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists mint_id on %%SCHEMA%%.mint (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists mint_updated_block_number on %%SCHEMA%%.mint (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_reserve_0 on %%SCHEMA%%.pair using btree ("reserve_0");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_reserve_0;`,
+			createStatement: `create index if not exists mint_id_block_range_fake_excl on %%SCHEMA%%.mint using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_reserve_1 on %%SCHEMA%%.pair using btree ("reserve_1");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_reserve_1;`,
+			createStatement: `create index if not exists mint_transaction on %%SCHEMA%%.mint using gist ("transaction", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_transaction;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_total_supply on %%SCHEMA%%.pair using btree ("total_supply");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_total_supply;`,
+			createStatement: `create index if not exists mint_timestamp on %%SCHEMA%%.mint using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_timestamp;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_reserve_eth on %%SCHEMA%%.pair using btree ("reserve_eth");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_reserve_eth;`,
+			createStatement: `create index if not exists mint_pair on %%SCHEMA%%.mint using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_pair;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_token_0_price on %%SCHEMA%%.pair using btree ("token_0_price");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_token_0_price;`,
+			createStatement: `create index if not exists mint_to on %%SCHEMA%%.mint ("left"("to", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_to;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_token_1_price on %%SCHEMA%%.pair using btree ("token_1_price");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_token_1_price;`,
+			createStatement: `create index if not exists mint_liquidity on %%SCHEMA%%.mint using btree ("liquidity");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_liquidity;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_volume_token_0 on %%SCHEMA%%.pair using btree ("volume_token_0");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_volume_token_0;`,
+			createStatement: `create index if not exists mint_sender on %%SCHEMA%%.mint ("left"("sender", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_sender;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_volume_token_1 on %%SCHEMA%%.pair using btree ("volume_token_1");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_volume_token_1;`,
+			createStatement: `create index if not exists mint_amount_0 on %%SCHEMA%%.mint using btree ("amount_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_amount_0;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_untracked_volume_usd on %%SCHEMA%%.pair using btree ("untracked_volume_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_untracked_volume_usd;`,
+			createStatement: `create index if not exists mint_amount_1 on %%SCHEMA%%.mint using btree ("amount_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_amount_1;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_tx_count on %%SCHEMA%%.pair using btree ("tx_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_tx_count;`,
+			createStatement: `create index if not exists mint_log_index on %%SCHEMA%%.mint using btree ("log_index");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_log_index;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_liquidity_provider_count on %%SCHEMA%%.pair using btree ("liquidity_provider_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_liquidity_provider_count;`,
+			createStatement: `create index if not exists mint_amount_usd on %%SCHEMA%%.mint using btree ("amount_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_amount_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_timestamp on %%SCHEMA%%.pair using btree ("timestamp");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_timestamp;`,
+			createStatement: `create index if not exists mint_fee_to on %%SCHEMA%%.mint ("left"("fee_to", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_fee_to;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_block on %%SCHEMA%%.pair using btree ("block");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_block;`,
+			createStatement: `create index if not exists mint_fee_liquidity on %%SCHEMA%%.mint using btree ("fee_liquidity");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.mint_fee_liquidity;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["pair_hour_data"] = func() []*index {
+	ddl.indexes["burn"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_block_range_closed on %%SCHEMA%%.pair_hour_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_block_range_closed;`,
+			createStatement: `create index if not exists burn_block_range_closed on %%SCHEMA%%.burn (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_block_range_closed;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_id on %%SCHEMA%%.pair_hour_data (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_id;`,
+			createStatement: `create index if not exists burn_id on %%SCHEMA%%.burn (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_id;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_updated_block_number on %%SCHEMA%%.pair_hour_data (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_updated_block_number;`,
+			createStatement: `create index if not exists burn_updated_block_number on %%SCHEMA%%.burn (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_id_block_range_fake_excl on %%SCHEMA%%.pair_hour_data using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists burn_id_block_range_fake_excl on %%SCHEMA%%.burn using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_date on %%SCHEMA%%.pair_hour_data using btree ("date");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_date;`,
+			createStatement: `create index if not exists burn_transaction on %%SCHEMA%%.burn using gist ("transaction", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_transaction;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_pair on %%SCHEMA%%.pair_hour_data using gist ("pair", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_pair;`,
+			createStatement: `create index if not exists burn_timestamp on %%SCHEMA%%.burn using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_timestamp;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_reserve_0 on %%SCHEMA%%.pair_hour_data using btree ("reserve_0");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_reserve_0;`,
+			createStatement: `create index if not exists burn_pair on %%SCHEMA%%.burn using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_pair;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_reserve_1 on %%SCHEMA%%.pair_hour_data using btree ("reserve_1");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_reserve_1;`,
+			createStatement: `create index if not exists burn_liquidity on %%SCHEMA%%.burn using btree ("liquidity");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_liquidity;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_reserve_usd on %%SCHEMA%%.pair_hour_data using btree ("reserve_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_reserve_usd;`,
+			createStatement: `create index if not exists burn_sender on %%SCHEMA%%.burn ("left"("sender", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_sender;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_volume_token_0 on %%SCHEMA%%.pair_hour_data using btree ("volume_token_0");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_volume_token_0;`,
+			createStatement: `create index if not exists burn_amount_0 on %%SCHEMA%%.burn using btree ("amount_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_amount_0;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_volume_token_1 on %%SCHEMA%%.pair_hour_data using btree ("volume_token_1");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_volume_token_1;`,
+			createStatement: `create index if not exists burn_amount_1 on %%SCHEMA%%.burn using btree ("amount_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_amount_1;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_volume_usd on %%SCHEMA%%.pair_hour_data using btree ("volume_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_volume_usd;`,
+			createStatement: `create index if not exists burn_to on %%SCHEMA%%.burn ("left"("to", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_to;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_hour_data_tx_count on %%SCHEMA%%.pair_hour_data using btree ("tx_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_hour_data_tx_count;`,
+			createStatement: `create index if not exists burn_log_index on %%SCHEMA%%.burn using btree ("log_index");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_log_index;`,
 		})
 
-		return indexes
-	}()
-
-	ddl.indexes["pair_day_data"] = func() []*index {
-		var indexes []*index
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_block_range_closed on %%SCHEMA%%.pair_day_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_block_range_closed;`,
-		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_id on %%SCHEMA%%.pair_day_data (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_id;`,
+			createStatement: `create index if not exists burn_amount_usd on %%SCHEMA%%.burn using btree ("amount_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_amount_usd;`,
 		})
+
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_updated_block_number on %%SCHEMA%%.pair_day_data (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_updated_block_number;`,
+			createStatement: `create index if not exists burn_complete on %%SCHEMA%%.burn using btree ("complete");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_complete;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_id_block_range_fake_excl on %%SCHEMA%%.pair_day_data using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists burn_fee_to on %%SCHEMA%%.burn ("left"("fee_to", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_fee_to;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_date on %%SCHEMA%%.pair_day_data using btree ("date");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_date;`,
+			createStatement: `create index if not exists burn_fee_liquidity on %%SCHEMA%%.burn using btree ("fee_liquidity");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.burn_fee_liquidity;`,
 		})
 
+		return indexes
+	}()
+
+	ddl.indexes["swap"] = func() []*index {
+		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_pair on %%SCHEMA%%.pair_day_data using gist ("pair", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_pair;`,
+			createStatement: `create index if not exists swap_block_range_closed on %%SCHEMA%%.swap (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_block_range_closed;`,
 		})
-
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_token_0 on %%SCHEMA%%.pair_day_data using gist ("token_0", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_0;`,
+			createStatement: `create index if not exists swap_id on %%SCHEMA%%.swap (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_id;`,
 		})
-
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_token_1 on %%SCHEMA%%.pair_day_data using gist ("token_1", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_token_1;`,
+			createStatement: `create index if not exists swap_updated_block_number on %%SCHEMA%%.swap (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_reserve_0 on %%SCHEMA%%.pair_day_data using btree ("reserve_0");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_reserve_0;`,
+			createStatement: `create index if not exists swap_id_block_range_fake_excl on %%SCHEMA%%.swap using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_reserve_1 on %%SCHEMA%%.pair_day_data using btree ("reserve_1");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_reserve_1;`,
+			createStatement: `create index if not exists swap_transaction on %%SCHEMA%%.swap using gist ("transaction", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_transaction;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_total_supply on %%SCHEMA%%.pair_day_data using btree ("total_supply");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_total_supply;`,
+			createStatement: `create index if not exists swap_timestamp on %%SCHEMA%%.swap using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_timestamp;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_reserve_usd on %%SCHEMA%%.pair_day_data using btree ("reserve_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_reserve_usd;`,
+			createStatement: `create index if not exists swap_pair on %%SCHEMA%%.swap using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_pair;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_volume_token_0 on %%SCHEMA%%.pair_day_data using btree ("volume_token_0");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_volume_token_0;`,
+			createStatement: `create index if not exists swap_sender on %%SCHEMA%%.swap ("left"("sender", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_sender;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_volume_token_1 on %%SCHEMA%%.pair_day_data using btree ("volume_token_1");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_volume_token_1;`,
+			createStatement: `create index if not exists swap_amount_0_in on %%SCHEMA%%.swap using btree ("amount_0_in");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_amount_0_in;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_volume_usd on %%SCHEMA%%.pair_day_data using btree ("volume_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_volume_usd;`,
+			createStatement: `create index if not exists swap_amount_1_in on %%SCHEMA%%.swap using btree ("amount_1_in");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_amount_1_in;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists pair_day_data_tx_count on %%SCHEMA%%.pair_day_data using btree ("tx_count");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.pair_day_data_tx_count;`,
+			createStatement: `create index if not exists swap_amount_0_out on %%SCHEMA%%.swap using btree ("amount_0_out");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_amount_0_out;`,
 		})
 
-		return indexes
-	}()
-
-	ddl.indexes["liquidity_position"] = func() []*index {
-		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_block_range_closed on %%SCHEMA%%.liquidity_position (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_block_range_closed;`,
+			createStatement: `create index if not exists swap_amount_1_out on %%SCHEMA%%.swap using btree ("amount_1_out");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_amount_1_out;`,
 		})
+
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_id on %%SCHEMA%%.liquidity_position (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_id;`,
+			createStatement: `create index if not exists swap_to on %%SCHEMA%%.swap ("left"("to", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_to;`,
 		})
+
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_updated_block_number on %%SCHEMA%%.liquidity_position (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_updated_block_number;`,
+			createStatement: `create index if not exists swap_log_index on %%SCHEMA%%.swap using btree ("log_index");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_log_index;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_id_block_range_fake_excl on %%SCHEMA%%.liquidity_position using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists swap_amount_usd on %%SCHEMA%%.swap using btree ("amount_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_amount_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_user on %%SCHEMA%%.liquidity_position using gist ("user", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_user;`,
+			createStatement: `create index if not exists swap_fees_token_0 on %%SCHEMA%%.swap using btree ("fees_token_0");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_fees_token_0;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_pair on %%SCHEMA%%.liquidity_position using gist ("pair", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_pair;`,
+			createStatement: `create index if not exists swap_fees_token_1 on %%SCHEMA%%.swap using btree ("fees_token_1");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_fees_token_1;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_liquidity_token_balance on %%SCHEMA%%.liquidity_position using btree ("liquidity_token_balance");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_liquidity_token_balance;`,
+			createStatement: `create index if not exists swap_fees_usd on %%SCHEMA%%.swap using btree ("fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_fees_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_block on %%SCHEMA%%.liquidity_position using btree ("block");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_block;`,
+			createStatement: `create index if not exists swap_lp_fees_usd on %%SCHEMA%%.swap using btree ("lp_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_lp_fees_usd;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_timestamp on %%SCHEMA%%.liquidity_position using btree ("timestamp");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_timestamp;`,
+			createStatement: `create index if not exists swap_protocol_fees_usd on %%SCHEMA%%.swap using btree ("protocol_fees_usd");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.swap_protocol_fees_usd;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["liquidity_position_snapshot"] = func() []*index {
+	ddl.indexes["claim"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_block_range_closed on %%SCHEMA%%.liquidity_position_snapshot (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_block_range_closed;`,
-		})
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_id on %%SCHEMA%%.liquidity_position_snapshot (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_id;`,
-		})
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_updated_block_number on %%SCHEMA%%.liquidity_position_snapshot (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_updated_block_number;`,
-		})
-
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_id_block_range_fake_excl on %%SCHEMA%%.liquidity_position_snapshot using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_id_block_range_fake_excl;`,
-		})
-
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_liquidity_position on %%SCHEMA%%.liquidity_position_snapshot using gist ("liquidity_position", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_liquidity_position;`,
-		})
-
-		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_timestamp on %%SCHEMA%%.liquidity_position_snapshot using btree ("timestamp");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_timestamp;`,
+			createStatement: `create index if not exists claim_block_range_closed on %%SCHEMA%%.claim (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_block_range_closed;`,
 		})
-
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_block on %%SCHEMA%%.liquidity_position_snapshot using btree ("block");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_block;`,
+			createStatement: `create index if not exists claim_id on %%SCHEMA%%.claim (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_id;`,
 		})
-
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_user on %%SCHEMA%%.liquidity_position_snapshot using gist ("user", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_user;`,
+			createStatement: `create index if not exists claim_updated_block_number on %%SCHEMA%%.claim (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_pair on %%SCHEMA%%.liquidity_position_snapshot using gist ("pair", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_pair;`,
+			createStatement: `create index if not exists claim_id_block_range_fake_excl on %%SCHEMA%%.claim using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_token_0_price_usd on %%SCHEMA%%.liquidity_position_snapshot using btree ("token_0_price_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_token_0_price_usd;`,
+			createStatement: `create index if not exists claim_index on %%SCHEMA%%.claim using btree ("index");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_index;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_token_1_price_usd on %%SCHEMA%%.liquidity_position_snapshot using btree ("token_1_price_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_token_1_price_usd;`,
+			createStatement: `create index if not exists claim_account on %%SCHEMA%%.claim ("left"("account", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_account;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_reserve_0 on %%SCHEMA%%.liquidity_position_snapshot using btree ("reserve_0");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_reserve_0;`,
+			createStatement: `create index if not exists claim_amount on %%SCHEMA%%.claim using btree ("amount");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_amount;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_reserve_1 on %%SCHEMA%%.liquidity_position_snapshot using btree ("reserve_1");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_reserve_1;`,
+			createStatement: `create index if not exists claim_week on %%SCHEMA%%.claim using btree ("week");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_week;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_reserve_usd on %%SCHEMA%%.liquidity_position_snapshot using btree ("reserve_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_reserve_usd;`,
+			createStatement: `create index if not exists claim_block on %%SCHEMA%%.claim using btree ("block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_block;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_liquidity_token_total_supply on %%SCHEMA%%.liquidity_position_snapshot using btree ("liquidity_token_total_supply");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_liquidity_token_total_supply;`,
+			createStatement: `create index if not exists claim_timestamp on %%SCHEMA%%.claim using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_timestamp;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists liquidity_position_snapshot_liquidity_token_balance on %%SCHEMA%%.liquidity_position_snapshot using btree ("liquidity_token_balance");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.liquidity_position_snapshot_liquidity_token_balance;`,
+			createStatement: `create index if not exists claim_transaction on %%SCHEMA%%.claim ("left"("transaction", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.claim_transaction;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["transaction"] = func() []*index {
+	ddl.indexes["merkle_root"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists transaction_block_range_closed on %%SCHEMA%%.transaction (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_block_range_closed;`,
+			createStatement: `create index if not exists merkle_root_block_range_closed on %%SCHEMA%%.merkle_root (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.merkle_root_block_range_closed;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists transaction_id on %%SCHEMA%%.transaction (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_id;`,
+			createStatement: `create index if not exists merkle_root_id on %%SCHEMA%%.merkle_root (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.merkle_root_id;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists transaction_updated_block_number on %%SCHEMA%%.transaction (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_updated_block_number;`,
+			createStatement: `create index if not exists merkle_root_updated_block_number on %%SCHEMA%%.merkle_root (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.merkle_root_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists transaction_id_block_range_fake_excl on %%SCHEMA%%.transaction using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists merkle_root_id_block_range_fake_excl on %%SCHEMA%%.merkle_root using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.merkle_root_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists transaction_block_number on %%SCHEMA%%.transaction using btree ("block_number");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_block_number;`,
+			createStatement: `create index if not exists merkle_root_root on %%SCHEMA%%.merkle_root ("left"("root", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.merkle_root_root;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists transaction_timestamp on %%SCHEMA%%.transaction using btree ("timestamp");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_timestamp;`,
+			createStatement: `create index if not exists merkle_root_week on %%SCHEMA%%.merkle_root using btree ("week");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.merkle_root_week;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists transaction_mints on %%SCHEMA%%.transaction using gin (mints);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_mints;`,
+			createStatement: `create index if not exists merkle_root_block on %%SCHEMA%%.merkle_root using btree ("block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.merkle_root_block;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists transaction_burns on %%SCHEMA%%.transaction using gin (burns);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_burns;`,
+			createStatement: `create index if not exists merkle_root_timestamp on %%SCHEMA%%.merkle_root using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.merkle_root_timestamp;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists transaction_swaps on %%SCHEMA%%.transaction using gin (swaps);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.transaction_swaps;`,
+			createStatement: `create index if not exists merkle_root_transaction on %%SCHEMA%%.merkle_root ("left"("transaction", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.merkle_root_transaction;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["mint"] = func() []*index {
+	ddl.indexes["sushi_supply"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_block_range_closed on %%SCHEMA%%.mint (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_block_range_closed;`,
+			createStatement: `create index if not exists sushi_supply_block_range_closed on %%SCHEMA%%.sushi_supply (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_block_range_closed;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_id on %%SCHEMA%%.mint (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_id;`,
+			createStatement: `create index if not exists sushi_supply_id on %%SCHEMA%%.sushi_supply (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_id;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_updated_block_number on %%SCHEMA%%.mint (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_updated_block_number;`,
+			createStatement: `create index if not exists sushi_supply_updated_block_number on %%SCHEMA%%.sushi_supply (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_id_block_range_fake_excl on %%SCHEMA%%.mint using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists sushi_supply_id_block_range_fake_excl on %%SCHEMA%%.sushi_supply using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_transaction on %%SCHEMA%%.mint using gist ("transaction", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_transaction;`,
+			createStatement: `create index if not exists sushi_supply_total_supply on %%SCHEMA%%.sushi_supply using btree ("total_supply");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_total_supply;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_timestamp on %%SCHEMA%%.mint using btree ("timestamp");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_timestamp;`,
+			createStatement: `create index if not exists sushi_supply_circulating_supply on %%SCHEMA%%.sushi_supply using btree ("circulating_supply");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_circulating_supply;`,
 		})
 
+		return indexes
+	}()
+
+	ddl.indexes["sushi_holder"] = func() []*index {
+		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_pair on %%SCHEMA%%.mint using gist ("pair", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_pair;`,
+			createStatement: `create index if not exists sushi_holder_block_range_closed on %%SCHEMA%%.sushi_holder (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists sushi_holder_id on %%SCHEMA%%.sushi_holder (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists sushi_holder_updated_block_number on %%SCHEMA%%.sushi_holder (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_to on %%SCHEMA%%.mint ("left"("to", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_to;`,
+			createStatement: `create index if not exists sushi_holder_id_block_range_fake_excl on %%SCHEMA%%.sushi_holder using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_liquidity on %%SCHEMA%%.mint using btree ("liquidity");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_liquidity;`,
+			createStatement: `create index if not exists sushi_holder_balance on %%SCHEMA%%.sushi_holder using btree ("balance");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_balance;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_sender on %%SCHEMA%%.mint ("left"("sender", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_sender;`,
+			createStatement: `create index if not exists sushi_holder_delegate on %%SCHEMA%%.sushi_holder using gist ("delegate", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_delegate;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_amount_0 on %%SCHEMA%%.mint using btree ("amount_0");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_amount_0;`,
+			createStatement: `create index if not exists sushi_holder_last_updated_block on %%SCHEMA%%.sushi_holder using btree ("last_updated_block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_last_updated_block;`,
 		})
 
+		return indexes
+	}()
+
+	ddl.indexes["sushi_supply_day_data"] = func() []*index {
+		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_amount_1 on %%SCHEMA%%.mint using btree ("amount_1");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_amount_1;`,
+			createStatement: `create index if not exists sushi_supply_day_data_block_range_closed on %%SCHEMA%%.sushi_supply_day_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_day_data_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists sushi_supply_day_data_id on %%SCHEMA%%.sushi_supply_day_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_day_data_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists sushi_supply_day_data_updated_block_number on %%SCHEMA%%.sushi_supply_day_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_day_data_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_log_index on %%SCHEMA%%.mint using btree ("log_index");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_log_index;`,
+			createStatement: `create index if not exists sushi_supply_day_data_id_block_range_fake_excl on %%SCHEMA%%.sushi_supply_day_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_day_data_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_amount_usd on %%SCHEMA%%.mint using btree ("amount_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_amount_usd;`,
+			createStatement: `create index if not exists sushi_supply_day_data_date on %%SCHEMA%%.sushi_supply_day_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_day_data_date;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_fee_to on %%SCHEMA%%.mint ("left"("fee_to", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_fee_to;`,
+			createStatement: `create index if not exists sushi_supply_day_data_total_supply on %%SCHEMA%%.sushi_supply_day_data using btree ("total_supply");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_day_data_total_supply;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists mint_fee_liquidity on %%SCHEMA%%.mint using btree ("fee_liquidity");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.mint_fee_liquidity;`,
+			createStatement: `create index if not exists sushi_supply_day_data_circulating_supply on %%SCHEMA%%.sushi_supply_day_data using btree ("circulating_supply");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_supply_day_data_circulating_supply;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["burn"] = func() []*index {
+	ddl.indexes["sushi_holder_day_data"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_block_range_closed on %%SCHEMA%%.burn (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_block_range_closed;`,
+			createStatement: `create index if not exists sushi_holder_day_data_block_range_closed on %%SCHEMA%%.sushi_holder_day_data (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_day_data_block_range_closed;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_id on %%SCHEMA%%.burn (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_id;`,
+			createStatement: `create index if not exists sushi_holder_day_data_id on %%SCHEMA%%.sushi_holder_day_data (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_day_data_id;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_updated_block_number on %%SCHEMA%%.burn (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_updated_block_number;`,
+			createStatement: `create index if not exists sushi_holder_day_data_updated_block_number on %%SCHEMA%%.sushi_holder_day_data (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_day_data_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_id_block_range_fake_excl on %%SCHEMA%%.burn using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists sushi_holder_day_data_id_block_range_fake_excl on %%SCHEMA%%.sushi_holder_day_data using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_day_data_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_transaction on %%SCHEMA%%.burn using gist ("transaction", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_transaction;`,
+			createStatement: `create index if not exists sushi_holder_day_data_date on %%SCHEMA%%.sushi_holder_day_data using btree ("date");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_day_data_date;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_timestamp on %%SCHEMA%%.burn using btree ("timestamp");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_timestamp;`,
+			createStatement: `create index if not exists sushi_holder_day_data_holder on %%SCHEMA%%.sushi_holder_day_data using gist ("holder", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_day_data_holder;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_pair on %%SCHEMA%%.burn using gist ("pair", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_pair;`,
+			createStatement: `create index if not exists sushi_holder_day_data_balance on %%SCHEMA%%.sushi_holder_day_data using btree ("balance");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_holder_day_data_balance;`,
 		})
 
+		return indexes
+	}()
+
+	ddl.indexes["sushi_delegate"] = func() []*index {
+		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_liquidity on %%SCHEMA%%.burn using btree ("liquidity");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_liquidity;`,
+			createStatement: `create index if not exists sushi_delegate_block_range_closed on %%SCHEMA%%.sushi_delegate (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_delegate_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists sushi_delegate_id on %%SCHEMA%%.sushi_delegate (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_delegate_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists sushi_delegate_updated_block_number on %%SCHEMA%%.sushi_delegate (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_delegate_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_sender on %%SCHEMA%%.burn ("left"("sender", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_sender;`,
+			createStatement: `create index if not exists sushi_delegate_id_block_range_fake_excl on %%SCHEMA%%.sushi_delegate using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_delegate_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_amount_0 on %%SCHEMA%%.burn using btree ("amount_0");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_amount_0;`,
+			createStatement: `create index if not exists sushi_delegate_votes on %%SCHEMA%%.sushi_delegate using btree ("votes");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_delegate_votes;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_amount_1 on %%SCHEMA%%.burn using btree ("amount_1");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_amount_1;`,
+			createStatement: `create index if not exists sushi_delegate_last_updated_block on %%SCHEMA%%.sushi_delegate using btree ("last_updated_block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_delegate_last_updated_block;`,
 		})
 
+		return indexes
+	}()
+
+	ddl.indexes["sushi_vote_change"] = func() []*index {
+		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_to on %%SCHEMA%%.burn ("left"("to", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_to;`,
+			createStatement: `create index if not exists sushi_vote_change_block_range_closed on %%SCHEMA%%.sushi_vote_change (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_vote_change_block_range_closed;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists sushi_vote_change_id on %%SCHEMA%%.sushi_vote_change (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_vote_change_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists sushi_vote_change_updated_block_number on %%SCHEMA%%.sushi_vote_change (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_vote_change_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_log_index on %%SCHEMA%%.burn using btree ("log_index");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_log_index;`,
+			createStatement: `create index if not exists sushi_vote_change_id_block_range_fake_excl on %%SCHEMA%%.sushi_vote_change using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_vote_change_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_amount_usd on %%SCHEMA%%.burn using btree ("amount_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_amount_usd;`,
+			createStatement: `create index if not exists sushi_vote_change_delegate on %%SCHEMA%%.sushi_vote_change using gist ("delegate", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_vote_change_delegate;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_complete on %%SCHEMA%%.burn using btree ("complete");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_complete;`,
+			createStatement: `create index if not exists sushi_vote_change_previous_votes on %%SCHEMA%%.sushi_vote_change using btree ("previous_votes");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_vote_change_previous_votes;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_fee_to on %%SCHEMA%%.burn ("left"("fee_to", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_fee_to;`,
+			createStatement: `create index if not exists sushi_vote_change_new_votes on %%SCHEMA%%.sushi_vote_change using btree ("new_votes");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_vote_change_new_votes;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists burn_fee_liquidity on %%SCHEMA%%.burn using btree ("fee_liquidity");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.burn_fee_liquidity;`,
+			createStatement: `create index if not exists sushi_vote_change_block on %%SCHEMA%%.sushi_vote_change using btree ("block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_vote_change_block;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists sushi_vote_change_timestamp on %%SCHEMA%%.sushi_vote_change using btree ("timestamp");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_vote_change_timestamp;`,
+		})
+
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists sushi_vote_change_transaction on %%SCHEMA%%.sushi_vote_change ("left"("transaction", 256));`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.sushi_vote_change_transaction;`,
 		})
 
 		return indexes
 	}()
 
-	ddl.indexes["swap"] = func() []*index {
+	ddl.indexes["master_chef_pool"] = func() []*index {
 		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_block_range_closed on %%SCHEMA%%.swap (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_block_range_closed;`,
+			createStatement: `create index if not exists master_chef_pool_block_range_closed on %%SCHEMA%%.master_chef_pool (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_pool_block_range_closed;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_id on %%SCHEMA%%.swap (id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_id;`,
+			createStatement: `create index if not exists master_chef_pool_id on %%SCHEMA%%.master_chef_pool (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_pool_id;`,
 		})
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_updated_block_number on %%SCHEMA%%.swap (_updated_block_number);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_updated_block_number;`,
+			createStatement: `create index if not exists master_chef_pool_updated_block_number on %%SCHEMA%%.master_chef_pool (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_pool_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_id_block_range_fake_excl on %%SCHEMA%%.swap using gist (block_range, id);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_id_block_range_fake_excl;`,
+			createStatement: `create index if not exists master_chef_pool_id_block_range_fake_excl on %%SCHEMA%%.master_chef_pool using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_pool_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_transaction on %%SCHEMA%%.swap using gist ("transaction", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_transaction;`,
+			createStatement: `create index if not exists master_chef_pool_pair on %%SCHEMA%%.master_chef_pool using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_pool_pair;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_timestamp on %%SCHEMA%%.swap using btree ("timestamp");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_timestamp;`,
+			createStatement: `create index if not exists master_chef_pool_last_updated_block on %%SCHEMA%%.master_chef_pool using btree ("last_updated_block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_pool_last_updated_block;`,
 		})
 
+		return indexes
+	}()
+
+	ddl.indexes["master_chef_stake"] = func() []*index {
+		var indexes []*index
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_pair on %%SCHEMA%%.swap using gist ("pair", block_range);`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_pair;`,
+			createStatement: `create index if not exists master_chef_stake_block_range_closed on %%SCHEMA%%.master_chef_stake (COALESCE(upper(block_range), 2147483647)) where (COALESCE(upper(block_range), 2147483647) < 2147483647);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_stake_block_range_closed;`,
 		})
-
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_sender on %%SCHEMA%%.swap ("left"("sender", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_sender;`,
+			createStatement: `create index if not exists master_chef_stake_id on %%SCHEMA%%.master_chef_stake (id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_stake_id;`,
+		})
+		indexes = append(indexes, &index{
+			createStatement: `create index if not exists master_chef_stake_updated_block_number on %%SCHEMA%%.master_chef_stake (_updated_block_number);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_stake_updated_block_number;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_amount_0_in on %%SCHEMA%%.swap using btree ("amount_0_in");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_amount_0_in;`,
+			createStatement: `create index if not exists master_chef_stake_id_block_range_fake_excl on %%SCHEMA%%.master_chef_stake using gist (block_range, id);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_stake_id_block_range_fake_excl;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_amount_1_in on %%SCHEMA%%.swap using btree ("amount_1_in");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_amount_1_in;`,
+			createStatement: `create index if not exists master_chef_stake_pool on %%SCHEMA%%.master_chef_stake using gist ("pool", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_stake_pool;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_amount_0_out on %%SCHEMA%%.swap using btree ("amount_0_out");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_amount_0_out;`,
+			createStatement: `create index if not exists master_chef_stake_user on %%SCHEMA%%.master_chef_stake using gist ("user", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_stake_user;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_amount_1_out on %%SCHEMA%%.swap using btree ("amount_1_out");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_amount_1_out;`,
+			createStatement: `create index if not exists master_chef_stake_amount on %%SCHEMA%%.master_chef_stake using btree ("amount");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_stake_amount;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_to on %%SCHEMA%%.swap ("left"("to", 256));`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_to;`,
+			createStatement: `create index if not exists master_chef_stake_pair on %%SCHEMA%%.master_chef_stake using gist ("pair", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_stake_pair;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_log_index on %%SCHEMA%%.swap using btree ("log_index");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_log_index;`,
+			createStatement: `create index if not exists master_chef_stake_liquidity_position on %%SCHEMA%%.master_chef_stake using gist ("liquidity_position", block_range);`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_stake_liquidity_position;`,
 		})
 
 		indexes = append(indexes, &index{
-			createStatement: `create index if not exists swap_amount_usd on %%SCHEMA%%.swap using btree ("amount_usd");`,
-			dropStatement:   `drop index if exists %%SCHEMA%%.swap_amount_usd;`,
+			createStatement: `create index if not exists master_chef_stake_last_updated_block on %%SCHEMA%%.master_chef_stake using btree ("last_updated_block");`,
+			dropStatement:   `drop index if exists %%SCHEMA%%.master_chef_stake_last_updated_block;`,
 		})
 
 		return indexes
@@ -6030,6 +10666,34 @@ func (t *TypedEntity) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		ent = tempEnt
+	case "eth_price_hour_data":
+		tempEnt := &EthPriceHourData{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "eth_price_day_data":
+		tempEnt := &EthPriceDayData{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "eth_price_source_data":
+		tempEnt := &EthPriceSourceData{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "price_anomaly":
+		tempEnt := &PriceAnomaly{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
 	case "factory":
 		tempEnt := &Factory{}
 		err := json.Unmarshal(s.Entity, &tempEnt)
@@ -6072,6 +10736,13 @@ func (t *TypedEntity) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		ent = tempEnt
+	case "token_interval_data":
+		tempEnt := &TokenIntervalData{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
 	case "pair":
 		tempEnt := &Pair{}
 		err := json.Unmarshal(s.Entity, &tempEnt)
@@ -6093,6 +10764,13 @@ func (t *TypedEntity) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		ent = tempEnt
+	case "pair_interval_data":
+		tempEnt := &PairIntervalData{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
 	case "liquidity_position":
 		tempEnt := &LiquidityPosition{}
 		err := json.Unmarshal(s.Entity, &tempEnt)
@@ -6100,6 +10778,13 @@ func (t *TypedEntity) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		ent = tempEnt
+	case "lp_allowance":
+		tempEnt := &LPAllowance{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
 	case "liquidity_position_snapshot":
 		tempEnt := &LiquidityPositionSnapshot{}
 		err := json.Unmarshal(s.Entity, &tempEnt)
@@ -6135,6 +10820,76 @@ func (t *TypedEntity) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		ent = tempEnt
+	case "claim":
+		tempEnt := &Claim{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "merkle_root":
+		tempEnt := &MerkleRoot{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "sushi_supply":
+		tempEnt := &SushiSupply{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "sushi_holder":
+		tempEnt := &SushiHolder{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "sushi_supply_day_data":
+		tempEnt := &SushiSupplyDayData{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "sushi_holder_day_data":
+		tempEnt := &SushiHolderDayData{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "sushi_delegate":
+		tempEnt := &SushiDelegate{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "sushi_vote_change":
+		tempEnt := &SushiVoteChange{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "master_chef_pool":
+		tempEnt := &MasterChefPool{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
+	case "master_chef_stake":
+		tempEnt := &MasterChefStake{}
+		err := json.Unmarshal(s.Entity, &tempEnt)
+		if err != nil {
+			return err
+		}
+		ent = tempEnt
 	}
 
 	t.Entity = ent
@@ -0,0 +1,88 @@
+package exchange
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/streamingfast/eth-go"
+)
+
+// sushiSupplyID is the id of the single `SushiSupply` entity
+const sushiSupplyID = "1"
+
+func (s *Subgraph) getSushiSupply() (*SushiSupply, error) {
+	supply := NewSushiSupply(sushiSupplyID)
+	if err := s.Load(supply); err != nil {
+		return nil, err
+	}
+
+	return supply, nil
+}
+
+func (s *Subgraph) getSushiHolder(address eth.Address) (*SushiHolder, error) {
+	holder := NewSushiHolder(address.Pretty())
+	if err := s.Load(holder); err != nil {
+		return nil, err
+	}
+
+	return holder, nil
+}
+
+// updateSushiSupplyDayData snapshots `supply` in the day data of the current day.
+func (s *Subgraph) updateSushiSupplyDayData(supply *SushiSupply) error {
+	timestamp := s.Block().Timestamp().Unix()
+	dayData := NewSushiSupplyDayData(strconv.FormatInt(DayBucket.ID(timestamp), 10))
+	dayData.Date = DayBucket.Start(timestamp)
+	dayData.TotalSupply = supply.TotalSupply
+	dayData.CirculatingSupply = supply.CirculatingSupply
+
+	return s.Save(dayData)
+}
+
+// updateSushiHolderDayData snapshots the balance of `holder` in its day data of the current day.
+func (s *Subgraph) updateSushiHolderDayData(holder *SushiHolder) error {
+	timestamp := s.Block().Timestamp().Unix()
+	dayData := NewSushiHolderDayData(fmt.Sprintf("%s-%d", holder.ID, DayBucket.ID(timestamp)))
+	dayData.Date = DayBucket.Start(timestamp)
+	dayData.Holder = holder.ID
+	dayData.Balance = holder.Balance
+
+	return s.Save(dayData)
+}
+
+// getSushiDelegate loads the delegate at `address`, saving it when it does not exist yet so
+// holders can reference it.
+func (s *Subgraph) getSushiDelegate(address eth.Address) (*SushiDelegate, error) {
+	delegate := NewSushiDelegate(address.Pretty())
+	if err := s.Load(delegate); err != nil {
+		return nil, err
+	}
+
+	if delegate.Exists() {
+		return delegate, nil
+	}
+
+	delegate.LastUpdatedBlock = int64(s.Block().Number())
+	if err := s.Save(delegate); err != nil {
+		return nil, err
+	}
+
+	return delegate, nil
+}
+
+// isCirculatingSushi returns whether the SUSHI held by `address` counts in the circulating
+// supply, the zero address and the non circulating addresses of the network do not.
+func isCirculatingSushi(address eth.Address) bool {
+	pretty := address.Pretty()
+	if pretty == ZeroAddress {
+		return false
+	}
+
+	for _, nonCirculating := range network.SushiNonCirculating {
+		if pretty == nonCirculating {
+			return false
+		}
+	}
+
+	return true
+}
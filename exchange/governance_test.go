@@ -0,0 +1,74 @@
+package exchange
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSushi returns `amount` SUSHI in the token base unit.
+func testSushi(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(sushiDecimals), nil))
+}
+
+func TestSubgraph_HandleSushiTokenTransferEvent(t *testing.T) {
+	zero := eth.MustNewAddress(ZeroAddress)
+	holder := eth.MustNewAddress("0x00000000000000000000000000000000000000f1")
+	nonCirculating := eth.MustNewAddress(mainnetMasterChef)
+
+	transfers := []*SushiTokenTransferEvent{
+		{From: zero, To: nonCirculating, Value: testSushi(100)},
+		{From: nonCirculating, To: holder, Value: testSushi(30)},
+		{From: holder, To: zero, Value: testSushi(10)},
+	}
+
+	tests := []struct {
+		name            string
+		step            int
+		expectedDayData bool
+	}{
+		{"balances step", 3, false},
+		{"final step", 99999, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			intrinsics := NewTestIntrinsics(nil)
+			intrinsics.step = test.step
+			s := NewTestSubgraph(intrinsics)
+
+			for i, transfer := range transfers {
+				transfer.BaseEvent = testBaseEvent(10)
+				transfer.LogIndex = i
+				require.NoError(t, s.HandleSushiTokenTransferEvent(transfer))
+			}
+
+			supply, err := s.getSushiSupply()
+			require.NoError(t, err)
+			assert.Equal(t, "90", supply.TotalSupply.Float().Text('f', -1))
+			assert.Equal(t, "20", supply.CirculatingSupply.Float().Text('f', -1))
+
+			sushiHolder, err := s.getSushiHolder(holder)
+			require.NoError(t, err)
+			assert.Equal(t, "20", sushiHolder.Balance.Float().Text('f', -1))
+
+			dayID := DayBucket.ID(s.Block().Timestamp().Unix())
+			supplyDayData := NewSushiSupplyDayData(fmt.Sprintf("%d", dayID))
+			require.NoError(t, s.Load(supplyDayData))
+			holderDayData := NewSushiHolderDayData(fmt.Sprintf("%s-%d", holder.Pretty(), dayID))
+			require.NoError(t, s.Load(holderDayData))
+
+			require.Equal(t, test.expectedDayData, supplyDayData.Exists())
+			require.Equal(t, test.expectedDayData, holderDayData.Exists())
+			if test.expectedDayData {
+				assert.Equal(t, "90", supplyDayData.TotalSupply.Float().Text('f', -1))
+				assert.Equal(t, "20", supplyDayData.CirculatingSupply.Float().Text('f', -1))
+				assert.Equal(t, "20", holderDayData.Balance.Float().Text('f', -1))
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package exchange
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/sparkle/entity"
+	"go.uber.org/zap"
+)
+
+// SUSHI has 18 decimals
+const sushiDecimals = 18
+
+func (s *Subgraph) HandleDistributorClaimedEvent(ev *DistributorClaimedEvent) error {
+	if s.StepBelow(4) {
+		return nil
+	}
+
+	s.Log.Debug("handling claimed event",
+		zap.Uint64("block_num", s.Block().Number()),
+		zap.String("trx_id", ev.Transaction.Hash.Pretty()),
+		zap.Reflect("event", ev),
+	)
+
+	claim := NewClaim(fmt.Sprintf("%s-%s", ev.Week.String(), ev.Index.String()))
+	claim.Index = I(ev.Index)
+	claim.Account = ev.Account.Pretty()
+	claim.Amount = F(entity.ConvertTokenToDecimal(ev.Amount, sushiDecimals))
+	claim.Week = I(ev.Week)
+	claim.Block = int64(s.Block().Number())
+	claim.Timestamp = s.Block().Timestamp().Unix()
+	claim.Transaction = ev.Transaction.Hash.Pretty()
+
+	if err := s.Save(claim); err != nil {
+		return fmt.Errorf("saving claim: %w", err)
+	}
+
+	return nil
+}
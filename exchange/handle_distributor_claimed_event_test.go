@@ -0,0 +1,32 @@
+package exchange
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubgraph_HandleDistributorClaimedEvent(t *testing.T) {
+	account := eth.MustNewAddress("0x00000000000000000000000000000000000000f1")
+	amount, _ := new(big.Int).SetString("1500000000000000000", 10)
+
+	s := NewTestSubgraph(NewTestIntrinsics(nil))
+	require.NoError(t, s.HandleDistributorClaimedEvent(&DistributorClaimedEvent{
+		BaseEvent: testBaseEvent(10),
+		Index:     big.NewInt(7),
+		Amount:    amount,
+		Account:   account,
+		Week:      big.NewInt(3),
+	}))
+
+	claim := NewClaim("3-7")
+	require.NoError(t, s.Load(claim))
+	require.True(t, claim.Exists())
+	assert.Equal(t, "1.5", claim.Amount.String())
+	assert.Equal(t, account.Pretty(), claim.Account)
+	assert.Equal(t, int64(7), claim.Index.Int().Int64())
+	assert.Equal(t, int64(3), claim.Week.Int().Int64())
+}
@@ -0,0 +1,33 @@
+package exchange
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+func (s *Subgraph) HandleDistributorMerkleRootUpdatedEvent(ev *DistributorMerkleRootUpdatedEvent) error {
+	if s.StepBelow(4) {
+		return nil
+	}
+
+	s.Log.Debug("handling merkle root updated event",
+		zap.Uint64("block_num", s.Block().Number()),
+		zap.String("trx_id", ev.Transaction.Hash.Pretty()),
+		zap.Reflect("event", ev),
+	)
+
+	// a week root can be replaced, every update is kept
+	root := NewMerkleRoot(fmt.Sprintf("%s-%d", ev.Transaction.Hash.Pretty(), ev.LogIndex))
+	root.Root = ev.MerkleRoot.Pretty()
+	root.Week = IL(int64(ev.Week))
+	root.Block = int64(s.Block().Number())
+	root.Timestamp = s.Block().Timestamp().Unix()
+	root.Transaction = ev.Transaction.Hash.Pretty()
+
+	if err := s.Save(root); err != nil {
+		return fmt.Errorf("saving merkle root: %w", err)
+	}
+
+	return nil
+}
@@ -5,7 +5,8 @@ import (
 )
 
 func (s *Subgraph) HandleFactoryPairCreatedEvent(ev *FactoryPairCreatedEvent) error {
-	factory, err := s.getFactory()
+	// the event comes from the network factory or one of its additional factories
+	factory, err := s.getFactory(ev.LogAddress.Pretty())
 	if err != nil {
 		return err
 	}
@@ -17,7 +18,7 @@ func (s *Subgraph) HandleFactoryPairCreatedEvent(ev *FactoryPairCreatedEvent) er
 		}
 	}
 
-	pair, err := s.getPair(ev.Pair, ev.Token0, ev.Token1)
+	pair, err := s.createPair(factory.ID, ev.Pair, ev.Token0, ev.Token1)
 	if err != nil {
 		return err
 	}
@@ -27,7 +28,7 @@ func (s *Subgraph) HandleFactoryPairCreatedEvent(ev *FactoryPairCreatedEvent) er
 		return err
 	}
 
-	err = s.CreatePairTemplateWithTokens(ev.Pair, ev.Token0, ev.Token1)
+	err = s.CreatePairTemplateWithTokens(ev.LogAddress, ev.Pair, ev.Token0, ev.Token1)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,31 @@
+package exchange
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/sparkle/entity"
+
+	"go.uber.org/zap"
+)
+
+func (s *Subgraph) HandleMasterChefEmergencyWithdrawEvent(ev *MasterChefEmergencyWithdrawEvent) error {
+	if s.StepBelow(2) {
+		return nil
+	}
+
+	s.Log.Debug("handling emergency withdraw event",
+		zap.Uint64("block_num", s.Block().Number()),
+		zap.String("trx_id", ev.Transaction.Hash.Pretty()),
+		zap.Reflect("event", ev),
+	)
+
+	// the whole stake is withdrawn without rewards, the event amount being all of it
+	value := entity.ConvertTokenToDecimal(ev.Amount, 18)
+
+	err := s.updateMasterChefStake(ev.User, ev.Pid, ev.LiquidityToken, ev.LogIndex, bf().Neg(value))
+	if err != nil {
+		return fmt.Errorf("updating stake: %w", err)
+	}
+
+	return nil
+}
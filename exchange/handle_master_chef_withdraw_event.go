@@ -0,0 +1,31 @@
+package exchange
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/sparkle/entity"
+
+	"go.uber.org/zap"
+)
+
+func (s *Subgraph) HandleMasterChefWithdrawEvent(ev *MasterChefWithdrawEvent) error {
+	if s.StepBelow(2) {
+		return nil
+	}
+
+	s.Log.Debug("handling withdraw event",
+		zap.Uint64("block_num", s.Block().Number()),
+		zap.String("trx_id", ev.Transaction.Hash.Pretty()),
+		zap.Reflect("event", ev),
+	)
+
+	// liquidity tokens have 18 decimals
+	value := entity.ConvertTokenToDecimal(ev.Amount, 18)
+
+	err := s.updateMasterChefStake(ev.User, ev.Pid, ev.LiquidityToken, ev.LogIndex, bf().Neg(value))
+	if err != nil {
+		return fmt.Errorf("updating stake: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package exchange
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/streamingfast/sparkle/entity"
+
+	"go.uber.org/zap"
+)
+
+// maxUint256 is the amount approved by "infinite" approvals
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+func (s *Subgraph) HandlePairApprovalEvent(ev *PairApprovalEvent) error {
+	if s.StepBelow(4) {
+		return nil
+	}
+
+	s.Log.Debug("handling approval event",
+		zap.Uint64("block_num", s.Block().Number()),
+		zap.String("trx_id", ev.Transaction.Hash.Pretty()),
+		zap.Reflect("event", ev),
+	)
+
+	pair := NewPair(ev.LogAddress.Pretty())
+	if err := s.Load(pair); err != nil {
+		return fmt.Errorf("loading pair id %s: %w", ev.LogAddress.Pretty(), err)
+	}
+
+	if !pair.Exists() {
+		return nil
+	}
+
+	allowance := NewLPAllowance(fmt.Sprintf("%s-%s-%s", pair.ID, ev.Owner.Pretty(), ev.Spender.Pretty()))
+	if err := s.Load(allowance); err != nil {
+		return err
+	}
+
+	allowance.Owner = ev.Owner.Pretty()
+	allowance.Spender = ev.Spender.Pretty()
+	allowance.Pair = pair.ID
+	// transferFrom lowers the allowance without an Approval event and its Transfer event does not
+	// name the spender, so the amount stays the last approved one
+	allowance.Amount = I(ev.Value)
+	allowance.Unlimited = entity.NewBool(ev.Value.Cmp(maxUint256) == 0)
+	allowance.LastUpdatedBlock = int64(s.Block().Number())
+	allowance.LastUpdatedTimestamp = s.Block().Timestamp().Unix()
+
+	if err := s.Save(allowance); err != nil {
+		return fmt.Errorf("saving allowance: %w", err)
+	}
+
+	return nil
+}
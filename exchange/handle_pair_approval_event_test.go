@@ -0,0 +1,50 @@
+package exchange
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubgraph_HandlePairApprovalEvent(t *testing.T) {
+	owner := eth.MustNewAddress("0x00000000000000000000000000000000000000f1")
+	spender := eth.MustNewAddress("0x00000000000000000000000000000000000000f2")
+
+	tests := []struct {
+		name              string
+		values            []*big.Int
+		expectedAmount    *big.Int
+		expectedUnlimited bool
+	}{
+		{"approved", []*big.Int{big.NewInt(100)}, big.NewInt(100), false},
+		{"unlimited", []*big.Int{maxUint256}, maxUint256, true},
+		{"last approval wins", []*big.Int{maxUint256, big.NewInt(0)}, big.NewInt(0), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTestSubgraph(NewTestIntrinsics(nil))
+			require.NoError(t, s.Save(NewPair(testPairAB)))
+
+			for _, value := range test.values {
+				require.NoError(t, s.HandlePairApprovalEvent(&PairApprovalEvent{
+					BaseEvent:  testBaseEvent(10),
+					LogAddress: eth.MustNewAddress(testPairAB),
+					Owner:      owner,
+					Spender:    spender,
+					Value:      value,
+				}))
+			}
+
+			allowance := NewLPAllowance(fmt.Sprintf("%s-%s-%s", testPairAB, owner.Pretty(), spender.Pretty()))
+			require.NoError(t, s.Load(allowance))
+			require.True(t, allowance.Exists())
+			assert.Equal(t, 0, test.expectedAmount.Cmp(allowance.Amount.Int()))
+			assert.Equal(t, test.expectedUnlimited, bool(allowance.Unlimited))
+		})
+	}
+}
@@ -15,7 +15,7 @@ func (s *Subgraph) HandlePairBurnEvent(ev *PairBurnEvent) error {
 		return err
 	}
 
-	pair, err := s.getPair(ev.LogAddress, nil, nil)
+	pair, err := s.getPair(ev.LogAddress)
 	if err != nil {
 		return err
 	}
@@ -44,7 +44,7 @@ func (s *Subgraph) HandlePairBurnEvent(ev *PairBurnEvent) error {
 		}
 	}
 
-	factory, err := s.getFactory()
+	factory, err := s.getFactory(pair.Factory)
 	if err != nil {
 		return err
 	}
@@ -105,32 +105,33 @@ func (s *Subgraph) HandlePairBurnEvent(ev *PairBurnEvent) error {
 		return err
 	}
 
+	if _, err := s.recordUserActivity(eth.MustNewAddress(*burn.Sender)); err != nil {
+		return err
+	}
+
 	position, err := s.createLiquidityPosition(eth.MustNewAddress(*burn.Sender), ev.LogAddress)
 	if err != nil {
 		return err
 	}
-	err = s.createLiquidityPositionSnapshot(position)
+	err = s.createLiquidityPositionSnapshot(position, ev.LogIndex)
 	if err != nil {
 		return err
 	}
 
 	// // update day entities
-	if _, err := s.UpdateFactoryDayData(); err != nil {
+	if _, err := s.UpdateFactoryDayData(pair.Factory); err != nil {
 		return err
 	}
 
-	if _, err := s.UpdatePairDayData(ev.LogAddress); err != nil {
+	if _, err := s.UpdateFactoryHourData(pair.Factory); err != nil {
 		return err
 	}
 
-	if _, err := s.UpdatePairHourData(ev.LogAddress); err != nil {
+	if _, err := s.UpdatePairBucketData(ev.LogAddress); err != nil {
 		return err
 	}
 
-	if _, err := s.UpdateTokenDayData(token0); err != nil {
-		return err
-	}
-	if _, err := s.UpdateTokenDayData(token1); err != nil {
+	if _, err := s.UpdateTokenBucketData(token0, token1); err != nil {
 		return err
 	}
 
@@ -24,12 +24,12 @@ func (s *Subgraph) HandlePairMintEvent(ev *PairMintEvent) error {
 	s.Log.Debug("mint things - mint", zap.String("to", eth.Address(mint.To).Pretty()))
 
 	pairAddress := ev.LogAddress
-	pair, err := s.getPair(pairAddress, nil, nil)
+	pair, err := s.getPair(pairAddress)
 	if err != nil {
 		return err
 	}
 
-	factory := NewFactory(FactoryAddress)
+	factory := NewFactory(pair.Factory)
 	if err := s.Load(factory); err != nil {
 		return err
 	}
@@ -89,33 +89,34 @@ func (s *Subgraph) HandlePairMintEvent(ev *PairMintEvent) error {
 		return err
 	}
 
+	if _, err := s.recordUserActivity(eth.MustNewAddress(mint.To)); err != nil {
+		return err
+	}
+
 	position, err := s.createLiquidityPosition(eth.Address(mint.To), pairAddress)
 	if err != nil {
 		return err
 	}
 
-	err = s.createLiquidityPositionSnapshot(position)
+	err = s.createLiquidityPositionSnapshot(position, ev.LogIndex)
 	if err != nil {
 		return err
 	}
 
 	// // update day entities
-	if _, err := s.UpdatePairDayData(ev.LogAddress); err != nil {
+	if _, err := s.UpdateFactoryDayData(pair.Factory); err != nil {
 		return err
 	}
 
-	if _, err := s.UpdatePairHourData(ev.LogAddress); err != nil {
+	if _, err := s.UpdateFactoryHourData(pair.Factory); err != nil {
 		return err
 	}
 
-	if _, err := s.UpdateFactoryDayData(); err != nil {
+	if _, err := s.UpdatePairBucketData(ev.LogAddress); err != nil {
 		return err
 	}
 
-	if _, err := s.UpdateTokenDayData(token0); err != nil {
-		return err
-	}
-	if _, err := s.UpdateTokenDayData(token1); err != nil {
+	if _, err := s.UpdateTokenBucketData(token0, token1); err != nil {
 		return err
 	}
 
@@ -2,11 +2,12 @@ package exchange
 
 import (
 	"fmt"
-	"go.uber.org/zap"
 	"math/big"
 
 	"github.com/streamingfast/eth-go"
 	"github.com/streamingfast/sparkle/entity"
+
+	"go.uber.org/zap"
 )
 
 func (s *Subgraph) HandlePairSwapEvent(ev *PairSwapEvent) error {
@@ -14,7 +15,7 @@ func (s *Subgraph) HandlePairSwapEvent(ev *PairSwapEvent) error {
 		return nil
 	}
 
-	pair, err := s.getPair(ev.LogAddress, nil, nil)
+	pair, err := s.getPair(ev.LogAddress)
 	if err != nil {
 		return fmt.Errorf("loading pair: %w", err)
 	}
@@ -94,6 +95,13 @@ func (s *Subgraph) HandlePairSwapEvent(ev *PairSwapEvent) error {
 		trackedAmountETH = bf().Quo(trackedAmountUSD, bundle.EthPrice.Float())
 	}
 
+	// fees are only taken on the input amounts
+	feesToken0 := bf().Mul(amount0In, SwapFeeRate)
+	feesToken1 := bf().Mul(amount1In, SwapFeeRate)
+	feesUSD := bf().Mul(trackedAmountUSD, SwapFeeRate)
+	lpFeesUSD := bf().Mul(trackedAmountUSD, LPFeeRate)
+	protocolFeesUSD := bf().Mul(trackedAmountUSD, ProtocolFeeRate)
+
 	// @ steps 3 trade  volume is realtive per shard
 	// @ steps 4 is where you should sqaush and it becomes absolute and that where you can save eneities
 
@@ -128,6 +136,11 @@ func (s *Subgraph) HandlePairSwapEvent(ev *PairSwapEvent) error {
 	pair.VolumeToken1 = entity.FloatAdd(pair.VolumeToken1, F(amount1Total))
 	pair.UntrackedVolumeUSD = entity.FloatAdd(pair.UntrackedVolumeUSD, F(derivedAmountUSD))
 	pair.TxCount = entity.IntAdd(pair.TxCount, IL(1))
+	pair.FeesToken0 = entity.FloatAdd(pair.FeesToken0, F(feesToken0))
+	pair.FeesToken1 = entity.FloatAdd(pair.FeesToken1, F(feesToken1))
+	pair.FeesUSD = entity.FloatAdd(pair.FeesUSD, F(feesUSD))
+	pair.LpFeesUSD = entity.FloatAdd(pair.LpFeesUSD, F(lpFeesUSD))
+	pair.ProtocolFeesUSD = entity.FloatAdd(pair.ProtocolFeesUSD, F(protocolFeesUSD))
 	if err := s.Save(pair); err != nil {
 		return fmt.Errorf("saving pair: %w", err)
 	}
@@ -143,7 +156,7 @@ func (s *Subgraph) HandlePairSwapEvent(ev *PairSwapEvent) error {
 
 	// update global values, only used tracked amounts for volume
 	if !isBlacklistedAddress(token0.ID) && !isBlacklistedAddress(token1.ID) {
-		factory, err := s.getFactory()
+		factory, err := s.getFactory(pair.Factory)
 		if err != nil {
 			return fmt.Errorf("loading factory: %w", err)
 		}
@@ -152,6 +165,9 @@ func (s *Subgraph) HandlePairSwapEvent(ev *PairSwapEvent) error {
 		factory.VolumeETH = entity.FloatAdd(factory.VolumeETH, F(trackedAmountETH))
 		factory.UntrackedVolumeUSD = entity.FloatAdd(factory.UntrackedVolumeUSD, F(derivedAmountUSD))
 		factory.TxCount = entity.IntAdd(factory.TxCount, IL(1))
+		factory.FeesUSD = entity.FloatAdd(factory.FeesUSD, F(feesUSD))
+		factory.LpFeesUSD = entity.FloatAdd(factory.LpFeesUSD, F(lpFeesUSD))
+		factory.ProtocolFeesUSD = entity.FloatAdd(factory.ProtocolFeesUSD, F(protocolFeesUSD))
 
 		if err := s.Save(factory); err != nil {
 			return fmt.Errorf("saving factory: %w", err)
@@ -205,6 +221,12 @@ func (s *Subgraph) HandlePairSwapEvent(ev *PairSwapEvent) error {
 		swap.AmountUSD = F(trackedAmountUSD)
 	}
 
+	swap.FeesToken0 = F(feesToken0)
+	swap.FeesToken1 = F(feesToken1)
+	swap.FeesUSD = F(feesUSD)
+	swap.LpFeesUSD = F(lpFeesUSD)
+	swap.ProtocolFeesUSD = F(protocolFeesUSD)
+
 	if err := s.Save(swap); err != nil {
 		return fmt.Errorf("saving swap: %w", err)
 	}
@@ -215,72 +237,123 @@ func (s *Subgraph) HandlePairSwapEvent(ev *PairSwapEvent) error {
 		return fmt.Errorf("saving transaction: %w", err)
 	}
 
-	pairDayData, err := s.UpdatePairDayData(ev.LogAddress)
-	if err != nil {
-		return fmt.Errorf("updating pair day data: %w", err)
+	if err := s.recordSwapUsers(ev, trackedAmountUSD); err != nil {
+		return fmt.Errorf("updating swap users: %w", err)
 	}
 
-	pairHourData, err := s.UpdatePairHourData(ev.LogAddress)
+	dayData, err := s.UpdateFactoryDayData(pair.Factory)
 	if err != nil {
-		return fmt.Errorf("updating pair hour data: %w", err)
+		return fmt.Errorf("update day data: %w", err)
 	}
 
-	dayData, err := s.UpdateFactoryDayData()
+	hourData, err := s.UpdateFactoryHourData(pair.Factory)
 	if err != nil {
-		return fmt.Errorf("update day data: %w", err)
+		return fmt.Errorf("update hour data: %w", err)
 	}
 
-	token0DayData, err := s.UpdateTokenDayData(token0)
+	pairBucketData, err := s.UpdatePairBucketData(ev.LogAddress)
 	if err != nil {
-		return fmt.Errorf("update token0 day data: %w", err)
+		return fmt.Errorf("updating pair bucket data: %w", err)
 	}
 
-	token1DayData, err := s.UpdateTokenDayData(token1)
+	tokenBucketData, err := s.UpdateTokenBucketData(token0, token1)
 	if err != nil {
-		return fmt.Errorf("udpate token1 day data: %w", err)
+		return fmt.Errorf("updating token bucket data: %w", err)
 	}
 
 	if !isBlacklistedAddress(token0.ID) && !isBlacklistedAddress(token1.ID) {
 		dayData.VolumeUSD = entity.FloatAdd(dayData.VolumeUSD, F(trackedAmountUSD))
 		dayData.VolumeETH = entity.FloatAdd(dayData.VolumeETH, F(trackedAmountETH))
 		dayData.UntrackedVolume = entity.FloatAdd(dayData.UntrackedVolume, F(derivedAmountUSD))
+		dayData.FeesUSD = entity.FloatAdd(dayData.FeesUSD, F(feesUSD))
+		dayData.LpFeesUSD = entity.FloatAdd(dayData.LpFeesUSD, F(lpFeesUSD))
+		dayData.ProtocolFeesUSD = entity.FloatAdd(dayData.ProtocolFeesUSD, F(protocolFeesUSD))
 		err = s.Save(dayData)
 		if err != nil {
 			return err
 		}
+
+		hourData.VolumeUSD = entity.FloatAdd(hourData.VolumeUSD, F(trackedAmountUSD))
+		hourData.VolumeETH = entity.FloatAdd(hourData.VolumeETH, F(trackedAmountETH))
+		hourData.UntrackedVolume = entity.FloatAdd(hourData.UntrackedVolume, F(derivedAmountUSD))
+		err = s.Save(hourData)
+		if err != nil {
+			return err
+		}
 	}
 
-	pairDayData.VolumeToken0 = entity.FloatAdd(pairDayData.VolumeToken0, F(amount0Total))
-	pairDayData.VolumeToken1 = entity.FloatAdd(pairDayData.VolumeToken1, F(amount1Total))
-	pairDayData.VolumeUSD = entity.FloatAdd(pairDayData.VolumeUSD, F(trackedAmountUSD))
-	err = s.Save(pairDayData)
-	if err != nil {
-		return err
+	for _, data := range pairBucketData {
+		fields := data.bucketFields()
+		*fields.VolumeToken0 = entity.FloatAdd(*fields.VolumeToken0, F(amount0Total))
+		*fields.VolumeToken1 = entity.FloatAdd(*fields.VolumeToken1, F(amount1Total))
+		*fields.VolumeUSD = entity.FloatAdd(*fields.VolumeUSD, F(trackedAmountUSD))
+		*fields.FeesToken0 = entity.FloatAdd(*fields.FeesToken0, F(feesToken0))
+		*fields.FeesToken1 = entity.FloatAdd(*fields.FeesToken1, F(feesToken1))
+		*fields.FeesUSD = entity.FloatAdd(*fields.FeesUSD, F(feesUSD))
+		*fields.LpFeesUSD = entity.FloatAdd(*fields.LpFeesUSD, F(lpFeesUSD))
+		*fields.ProtocolFeesUSD = entity.FloatAdd(*fields.ProtocolFeesUSD, F(protocolFeesUSD))
+		if fields.ReserveUSDSum != nil {
+			*fields.ReserveUSDSum = entity.FloatAdd(*fields.ReserveUSDSum, pair.ReserveUSD)
+			*fields.ReserveUSDSamples = entity.IntAdd(*fields.ReserveUSDSamples, IL(1))
+		}
+		if apr, ok := data.(aprUpdater); ok {
+			apr.updateAPR()
+		}
+
+		err = s.Save(data)
+		if err != nil {
+			return err
+		}
 	}
 
-	pairHourData.VolumeToken0 = entity.FloatAdd(pairHourData.VolumeToken0, F(amount0Total))
-	pairHourData.VolumeToken1 = entity.FloatAdd(pairHourData.VolumeToken1, F(amount1Total))
-	pairHourData.VolumeUSD = entity.FloatAdd(pairHourData.VolumeUSD, F(trackedAmountUSD))
-	err = s.Save(pairHourData)
-	if err != nil {
+	pair.addAPRSwap(s.Block().Timestamp().Unix(), lpFeesUSD)
+	if err := s.Save(pair); err != nil {
+		return fmt.Errorf("saving pair: %w", err)
+	}
+
+	amounts := []*big.Float{amount0Total, amount1Total}
+	for i, token := range []*Token{token0, token1} {
+		amount := amounts[i]
+		amountETH := bf().Mul(amount, token.DerivedETH.Float())
+		for _, data := range tokenBucketData[i] {
+			fields := data.bucketFields()
+			*fields.Volume = entity.FloatAdd(*fields.Volume, F(amount))
+			*fields.VolumeETH = entity.FloatAdd(*fields.VolumeETH, F(amountETH))
+			*fields.VolumeUSD = entity.FloatAdd(*fields.VolumeUSD, F(bf().Mul(amountETH, bundle.EthPrice.Float())))
+
+			err = s.Save(data)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordSwapUsers marks the swap sender and recipient active, the swap being credited to the
+// recipient unless it is a pair, as in the intermediate hops of a routed swap.
+func (s *Subgraph) recordSwapUsers(ev *PairSwapEvent, trackedAmountUSD *big.Float) error {
+	if _, err := s.recordUserActivity(ev.Sender); err != nil {
 		return err
 	}
 
-	token0DayData.Volume = entity.FloatAdd(token0DayData.Volume, F(amount0Total))
-	token0DayData.VolumeETH = entity.FloatAdd(token0DayData.VolumeETH, F(bf().Mul(amount0Total, token0.DerivedETH.Float())))
-	token0DayData.VolumeUSD = entity.FloatAdd(token0DayData.VolumeUSD, F(bf().Mul(bf().Mul(amount0Total, token0.DerivedETH.Float()), bundle.EthPrice.Float())))
-	err = s.Save(token0DayData)
-	if err != nil {
+	recipientPair := NewPair(ev.To.Pretty())
+	if err := s.Load(recipientPair); err != nil {
 		return err
 	}
 
-	token1DayData.Volume = entity.FloatAdd(token1DayData.Volume, F(amount1Total))
-	token1DayData.VolumeETH = entity.FloatAdd(token1DayData.VolumeETH, F(bf().Mul(amount1Total, token1.DerivedETH.Float())))
-	token1DayData.VolumeUSD = entity.FloatAdd(token1DayData.VolumeUSD, F(bf().Mul(bf().Mul(amount1Total, token1.DerivedETH.Float()), bundle.EthPrice.Float())))
-	err = s.Save(token1DayData)
+	if recipientPair.Exists() {
+		return nil
+	}
+
+	trader, err := s.recordUserActivity(ev.To)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	trader.UsdSwapped = entity.FloatAdd(trader.UsdSwapped, F(trackedAmountUSD))
+	trader.SwapCount = entity.IntAdd(trader.SwapCount, IL(1))
+
+	return s.Save(trader)
 }
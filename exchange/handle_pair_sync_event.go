@@ -15,7 +15,7 @@ func (s *Subgraph) HandlePairSyncEvent(ev *PairSyncEvent) error {
 		return nil
 	}
 
-	pair, err := s.getPair(ev.LogAddress, nil, nil)
+	pair, err := s.getPair(ev.LogAddress)
 	if err != nil {
 		return err
 	}
@@ -36,7 +36,7 @@ func (s *Subgraph) HandlePairSyncEvent(ev *PairSyncEvent) error {
 	}
 	s.Log.Debug("current derived eth token 1", zap.String("token", token1.Symbol), zap.String("pair_name", pair.Name), zap.Stringer("value", token1.DerivedETH))
 
-	factory := NewFactory(FactoryAddress)
+	factory := NewFactory(pair.Factory)
 	if err := s.Load(factory); err != nil {
 		return err
 	}
@@ -86,6 +86,13 @@ func (s *Subgraph) HandlePairSyncEvent(ev *PairSyncEvent) error {
 	}
 	zlog.Debug("pair token1 price after", zap.Int("step", s.Step()), zap.Uint64("block", s.Block().Number()), zap.String("pair_name", pair.Name), zap.String("value", pair.Token1Price.Float().Text('g', -1)))
 
+	// the accumulators are step 2 fields, later steps keep the merged values
+	if !s.StepAbove(2) {
+		if err := s.updatePairPriceAccumulators(pair, ev.Reserve0, ev.Reserve1); err != nil {
+			return fmt.Errorf("updating price accumulators of pair %s: %w", pair.ID, err)
+		}
+	}
+
 	err = s.Save(pair)
 	if err != nil {
 		return err
@@ -95,7 +102,7 @@ func (s *Subgraph) HandlePairSyncEvent(ev *PairSyncEvent) error {
 		return nil
 	}
 
-	ethPrice, err := s.GetEthPriceInUSD()
+	ethPrice, ethPriceQuotes, err := s.GetEthPriceInUSD()
 	if err != nil {
 		return err
 	}
@@ -106,26 +113,48 @@ func (s *Subgraph) HandlePairSyncEvent(ev *PairSyncEvent) error {
 	}
 
 	prevEthPrice := bundle.EthPrice
-	bundle.EthPrice = F(ethPrice)
+	reason := updateBundleEthPrice(bundle, ethPrice, ev.Block.Number)
 	if err := s.Save(bundle); err != nil {
 		return err
 	}
+
+	// a rejected price leaves the ETH price data untouched, its quotes included
+	if reason != "" {
+		if !s.StepBelow(4) {
+			if err := s.savePriceAnomaly(ev, pair, bundle.EthPrice.Float(), ethPrice, reason); err != nil {
+				return err
+			}
+		}
+	} else if !s.StepBelow(4) && bundle.EthPrice.Float().Sign() != 0 {
+		if _, err := s.UpdateEthPriceHourData(bundle.EthPrice.Float(), ethPriceQuotes); err != nil {
+			return err
+		}
+		if _, err := s.UpdateEthPriceDayData(bundle.EthPrice.Float(), ethPriceQuotes); err != nil {
+			return err
+		}
+	}
 	s.Log.Debug("updated bundle price", zap.Int("step", s.Step()), zap.Uint64("block", s.Block().Number()), zap.String("pair_name", pair.Name), zap.Reflect("bundle", bundle), zap.Any("prev_eth_price", prevEthPrice), zap.Uint64("block_number", ev.Block.Number), zap.Stringer("transaction_id", ev.Transaction.Hash))
 
-	t0DerivedETH, err := s.FindEthPerToken(token0)
+	t0Route, err := s.FindEthPerToken(token0)
 	if err != nil {
 		return err
 	}
+	t0DerivedETH := t0Route.Price
 	zlog.Debug("calculated derived ETH price for token0", zap.Int("step", s.Step()), zap.Uint64("block", s.Block().Number()), zap.String("pair_name", pair.Name), zap.String("token", token0.Symbol), zap.String("value", t0DerivedETH.Text('g', -1)))
 
-	t1DerivedETH, err := s.FindEthPerToken(token1)
+	t1Route, err := s.FindEthPerToken(token1)
 	if err != nil {
 		return err
 	}
+	t1DerivedETH := t1Route.Price
 	zlog.Debug("calculated derived ETH price for token1", zap.Int("step", s.Step()), zap.Uint64("block", s.Block().Number()), zap.String("pair_name", pair.Name), zap.String("token", token1.Symbol), zap.String("value", t1DerivedETH.Text('g', -1)))
 
 	token0.DerivedETH = F(t0DerivedETH)
+	token0.DerivedETHRoute = t0Route.Pairs
+	token0.DerivedETHHops = int64(t0Route.Hops)
 	token1.DerivedETH = F(t1DerivedETH)
+	token1.DerivedETHRoute = t1Route.Pairs
+	token1.DerivedETHHops = int64(t1Route.Hops)
 
 	if err := s.Save(token0); err != nil {
 		return err
@@ -206,12 +235,17 @@ func (s *Subgraph) HandlePairSyncEvent(ev *PairSyncEvent) error {
 	factory.LiquidityETH = entity.FloatAdd(factory.LiquidityETH, F(trackedLiquidityETH))
 	factory.LiquidityUSD = F(bf().Mul(
 		factory.LiquidityETH.Float(),
-		ethPrice,
+		bundle.EthPrice.Float(),
 	))
 
 	token0.Liquidity = entity.FloatAdd(token0.Liquidity, pair.Reserve0)
 	token1.Liquidity = entity.FloatAdd(token1.Liquidity, pair.Reserve1)
 
+	if !s.StepBelow(4) && pair.advanceAPRWindow(s.Block().Timestamp().Unix()) {
+		// the window moves with time, not only with swaps, at most once per block
+		pair.updateAPR()
+	}
+
 	// save entities
 	if err := s.Save(pair); err != nil {
 		return err
@@ -229,5 +263,11 @@ func (s *Subgraph) HandlePairSyncEvent(ev *PairSyncEvent) error {
 		return err
 	}
 
+	if !s.StepBelow(4) {
+		if err := s.UpdatePairCandles(pair, token0, token1, bundle.EthPrice.Float()); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
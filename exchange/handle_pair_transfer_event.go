@@ -11,7 +11,7 @@ import (
 )
 
 func (s *Subgraph) HandlePairTransferEvent(ev *PairTransferEvent) error {
-	if s.StepBelow(4) {
+	if s.StepBelow(3) {
 		return nil
 	}
 
@@ -26,26 +26,36 @@ func (s *Subgraph) HandlePairTransferEvent(ev *PairTransferEvent) error {
 		return nil
 	}
 
-	factory, err := s.getFactory()
+	// get pair and load contract
+	pair, err := s.getPair(ev.LogAddress)
 	if err != nil {
-		return err
+		return fmt.Errorf("loading pair id %s: %w", ev.LogAddress.Pretty(), err)
 	}
 
-	if _, err := s.getUser(ev.From); err != nil {
-		return err
-	}
-	if _, err := s.getUser(ev.To); err != nil {
-		return err
+	// liquidity token amount being transferred
+	value := entity.ConvertTokenToDecimal(ev.Value, 18)
+
+	// position balances are summed across parallel shards at step 3, the rest of the transfer is
+	// handled from step 4 on top of the merged balances
+	if s.StepBelow(4) {
+		return s.transferLiquidityPositions(ev, pair, value)
 	}
 
-	// get pair and load contract
-	pair, err := s.getPair(ev.LogAddress, nil, nil)
+	factory, err := s.getFactory(pair.Factory)
 	if err != nil {
-		return fmt.Errorf("loading pair id %s: %w", ev.LogAddress.Pretty(), err)
+		return err
 	}
 
-	// liquidity token amount being transferred
-	value := entity.ConvertTokenToDecimal(ev.Value, 18)
+	// mints and burns move tokens from and to the zero address and the pair itself, staking to
+	// MasterChef, none of them being users
+	for _, address := range []eth.Address{ev.From, ev.To} {
+		if !isLiquidityHolder(address.Pretty(), pair) {
+			continue
+		}
+		if _, err := s.recordUserActivity(address); err != nil {
+			return err
+		}
+	}
 
 	// get or create transaction
 	trx := NewTransaction(ev.Transaction.Hash.Pretty())
@@ -212,54 +222,59 @@ func (s *Subgraph) HandlePairTransferEvent(ev *PairTransferEvent) error {
 		return err
 	}
 
-	// burn
-	if ev.From.Pretty() != ZeroAddress && ev.From.Pretty() != pair.ID {
-		position, err := s.createLiquidityPosition(ev.From, ev.LogAddress)
-		if err != nil {
-			return err
-		}
+	if err := s.transferLiquidityPositions(ev, pair, value); err != nil {
+		return err
+	}
 
-		position.LiquidityTokenBalance = F(bf().Sub(
-			position.LiquidityTokenBalance.Float(),
-			value,
-		))
+	if err := s.Save(pair); err != nil {
+		return err
+	}
 
-		err = s.Save(position)
-		if err != nil {
-			return err
+	if err := s.Save(trx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// transferLiquidityPositions moves `value` liquidity tokens between the positions of the sender
+// and the recipient, snapshotting them from step 4.
+func (s *Subgraph) transferLiquidityPositions(ev *PairTransferEvent, pair *Pair, value *big.Float) error {
+	transfers := []struct {
+		address eth.Address
+		delta   *big.Float
+	}{
+		{ev.From, bf().Neg(value)},
+		{ev.To, value},
+	}
+
+	for _, transfer := range transfers {
+		if !isLiquidityHolder(transfer.address.Pretty(), pair) {
+			continue
 		}
 
-		err = s.createLiquidityPositionSnapshot(position)
+		position, err := s.createLiquidityPosition(transfer.address, ev.LogAddress)
 		if err != nil {
 			return err
 		}
-	}
 
-	//mint
-	if ev.To.Pretty() != ZeroAddress && ev.To.Pretty() != pair.ID {
-		position, err := s.createLiquidityPosition(ev.To, ev.LogAddress)
-		if err != nil {
+		totalBefore := position.TotalLiquidityTokenBalance.Float()
+		position.LiquidityTokenBalance = F(bf().Add(position.LiquidityTokenBalance.Float(), transfer.delta))
+		if err := s.updatePositionTotalBalance(position, pair, totalBefore); err != nil {
 			return err
 		}
 
-		position.LiquidityTokenBalance = F(bf().Add(
-			position.LiquidityTokenBalance.Float(),
-			value,
-		))
-
-		err = s.Save(position)
-		if err != nil {
+		if err := s.Save(position); err != nil {
 			return err
 		}
 
-		err = s.createLiquidityPositionSnapshot(position)
-		if err != nil {
-			return err
+		if s.StepBelow(4) {
+			continue
 		}
-	}
 
-	if err := s.Save(trx); err != nil {
-		return err
+		if err := s.createLiquidityPositionSnapshot(position, ev.LogIndex); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,41 @@
+package exchange
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+func (s *Subgraph) HandleSushiTokenDelegateChangedEvent(ev *SushiTokenDelegateChangedEvent) error {
+	if s.StepBelow(4) {
+		return nil
+	}
+
+	s.Log.Debug("handling delegate changed event",
+		zap.Uint64("block_num", s.Block().Number()),
+		zap.String("trx_id", ev.Transaction.Hash.Pretty()),
+		zap.Reflect("event", ev),
+	)
+
+	holder, err := s.getSushiHolder(ev.Delegator)
+	if err != nil {
+		return err
+	}
+
+	// delegating to the zero address removes the delegation
+	holder.Delegate = nil
+	if ev.ToDelegate.Pretty() != ZeroAddress {
+		delegate, err := s.getSushiDelegate(ev.ToDelegate)
+		if err != nil {
+			return err
+		}
+		holder.Delegate = &delegate.ID
+	}
+	holder.LastUpdatedBlock = int64(s.Block().Number())
+
+	if err := s.Save(holder); err != nil {
+		return fmt.Errorf("saving sushi holder: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package exchange
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/sparkle/entity"
+	"go.uber.org/zap"
+)
+
+func (s *Subgraph) HandleSushiTokenDelegateVotesChangedEvent(ev *SushiTokenDelegateVotesChangedEvent) error {
+	if s.StepBelow(4) {
+		return nil
+	}
+
+	s.Log.Debug("handling delegate votes changed event",
+		zap.Uint64("block_num", s.Block().Number()),
+		zap.String("trx_id", ev.Transaction.Hash.Pretty()),
+		zap.Reflect("event", ev),
+	)
+
+	delegate, err := s.getSushiDelegate(ev.Delegate)
+	if err != nil {
+		return err
+	}
+
+	newVotes := entity.ConvertTokenToDecimal(ev.NewBalance, sushiDecimals)
+	delegate.Votes = F(newVotes)
+	delegate.LastUpdatedBlock = int64(s.Block().Number())
+
+	if err := s.Save(delegate); err != nil {
+		return fmt.Errorf("saving sushi delegate: %w", err)
+	}
+
+	change := NewSushiVoteChange(fmt.Sprintf("%s-%d", ev.Transaction.Hash.Pretty(), ev.LogIndex))
+	change.Delegate = delegate.ID
+	change.PreviousVotes = F(entity.ConvertTokenToDecimal(ev.PreviousBalance, sushiDecimals))
+	change.NewVotes = F(newVotes)
+	change.Block = int64(s.Block().Number())
+	change.Timestamp = s.Block().Timestamp().Unix()
+	change.Transaction = ev.Transaction.Hash.Pretty()
+
+	if err := s.Save(change); err != nil {
+		return fmt.Errorf("saving sushi vote change: %w", err)
+	}
+
+	return nil
+}
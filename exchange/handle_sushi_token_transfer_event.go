@@ -0,0 +1,90 @@
+package exchange
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/sparkle/entity"
+
+	"go.uber.org/zap"
+)
+
+func (s *Subgraph) HandleSushiTokenTransferEvent(ev *SushiTokenTransferEvent) error {
+	if s.StepBelow(3) {
+		return nil
+	}
+
+	s.Log.Debug("handling sushi transfer event",
+		zap.Uint64("block_num", s.Block().Number()),
+		zap.String("trx_id", ev.Transaction.Hash.Pretty()),
+		zap.Reflect("event", ev),
+	)
+
+	value := entity.ConvertTokenToDecimal(ev.Value, sushiDecimals)
+	negValue := bf().Neg(value)
+
+	// supplies and balances are summed across parallel shards at step 3, only their changes are
+	// recorded, the day data snapshot them from step 4 once they are merged
+	supply, err := s.getSushiSupply()
+	if err != nil {
+		return err
+	}
+
+	if ev.From.Pretty() == ZeroAddress {
+		supply.TotalSupply = entity.FloatAdd(supply.TotalSupply, F(value))
+	}
+	if ev.To.Pretty() == ZeroAddress {
+		supply.TotalSupply = entity.FloatAdd(supply.TotalSupply, F(negValue))
+	}
+	if isCirculatingSushi(ev.From) {
+		supply.CirculatingSupply = entity.FloatAdd(supply.CirculatingSupply, F(negValue))
+	}
+	if isCirculatingSushi(ev.To) {
+		supply.CirculatingSupply = entity.FloatAdd(supply.CirculatingSupply, F(value))
+	}
+
+	if err := s.Save(supply); err != nil {
+		return fmt.Errorf("saving sushi supply: %w", err)
+	}
+
+	if !s.StepBelow(4) {
+		if err := s.updateSushiSupplyDayData(supply); err != nil {
+			return fmt.Errorf("saving sushi supply day data: %w", err)
+		}
+	}
+
+	if err := s.updateSushiHolderBalance(ev.From, negValue); err != nil {
+		return fmt.Errorf("updating sender balance: %w", err)
+	}
+
+	if err := s.updateSushiHolderBalance(ev.To, value); err != nil {
+		return fmt.Errorf("updating recipient balance: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Subgraph) updateSushiHolderBalance(address eth.Address, delta *big.Float) error {
+	if address.Pretty() == ZeroAddress {
+		return nil
+	}
+
+	holder, err := s.getSushiHolder(address)
+	if err != nil {
+		return err
+	}
+
+	holder.Balance = entity.FloatAdd(holder.Balance, F(delta))
+	holder.LastUpdatedBlock = int64(s.Block().Number())
+
+	if err := s.Save(holder); err != nil {
+		return err
+	}
+
+	if s.StepBelow(4) {
+		return nil
+	}
+
+	return s.updateSushiHolderDayData(holder)
+}
@@ -2,14 +2,18 @@ package exchange
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/sparkle/entity"
 )
 
-func (s *Subgraph) createLiquidityPosition(user, pair eth.Address) (*LiquidityPosition, error) {
-	id := fmt.Sprintf("%s-%s", pair.Pretty(), user.Pretty())
+func liquidityPositionID(pairAddress, userAddress string) string {
+	return fmt.Sprintf("%s-%s", pairAddress, userAddress)
+}
 
-	position := NewLiquidityPosition(id)
+func (s *Subgraph) createLiquidityPosition(user, pair eth.Address) (*LiquidityPosition, error) {
+	position := NewLiquidityPosition(liquidityPositionID(pair.Pretty(), user.Pretty()))
 	if err := s.Load(position); err != nil {
 		return nil, err
 	}
@@ -31,15 +35,47 @@ func (s *Subgraph) createLiquidityPosition(user, pair eth.Address) (*LiquidityPo
 	return position, nil
 }
 
-func (s *Subgraph) createLiquidityPositionSnapshot(position *LiquidityPosition) error {
-	id := fmt.Sprintf("%s-%d", position.ID, s.Block().Timestamp().Unix())
+// isLiquidityHolder tells whether `address` holds liquidity tokens of `pair` on its own behalf.
+// The zero address and the pair only see them transit through mints and burns, MasterChef holds
+// the staked ones, counted in the positions of their stakers.
+func isLiquidityHolder(address string, pair *Pair) bool {
+	return address != ZeroAddress && address != pair.ID && address != network.MasterChefAddress
+}
+
+// updateTotalBalance sums the wallet and staked balances of the position.
+func (p *LiquidityPosition) updateTotalBalance() {
+	p.TotalLiquidityTokenBalance = F(bf().Add(p.LiquidityTokenBalance.Float(), p.StakedLiquidityTokenBalance.Float()))
+}
+
+// updatePositionTotalBalance refreshes the total balance of `position` after a change of its
+// wallet or staked balance, `totalBefore` being the total before the change. A position staking
+// all of its tokens is still providing liquidity, the liquidity provider counts of `pair` and of
+// the user follow the total balance. Balances are summed across parallel shards at step 3, so
+// the counts are only updated from step 4, when the balances before and after are merged ones.
+func (s *Subgraph) updatePositionTotalBalance(position *LiquidityPosition, pair *Pair, totalBefore *big.Float) error {
+	position.updateTotalBalance()
+	if s.StepBelow(4) {
+		return nil
+	}
+
+	totalAfter := position.TotalLiquidityTokenBalance.Float()
+
+	pair.LiquidityProviderCount = entity.IntAdd(pair.LiquidityProviderCount, IL(liquidityProviderCountDelta(totalBefore, totalAfter)))
+
+	return s.updateUserActivePositions(eth.MustNewAddress(position.User), totalBefore, totalAfter)
+}
+
+// createLiquidityPositionSnapshot records the state of `position` after the event at `logIndex`,
+// a position can be snapshotted several times within a block.
+func (s *Subgraph) createLiquidityPositionSnapshot(position *LiquidityPosition, logIndex int) error {
+	id := fmt.Sprintf("%s-%d-%d", position.ID, s.Block().Timestamp().Unix(), logIndex)
 
 	bundle, err := s.getBundle()
 	if err != nil {
 		return err
 	}
 
-	pair, err := s.getPair(eth.MustNewAddress(position.Pair), nil, nil)
+	pair, err := s.getPair(eth.MustNewAddress(position.Pair))
 	if err != nil {
 		return err
 	}
@@ -56,6 +92,7 @@ func (s *Subgraph) createLiquidityPositionSnapshot(position *LiquidityPosition)
 
 	snapshot := NewLiquidityPositionSnapshot(id)
 	snapshot.Timestamp = s.Block().Timestamp().Unix()
+	snapshot.Block = int64(s.Block().Number())
 	snapshot.User = position.User
 	snapshot.Pair = position.Pair
 	snapshot.Token0PriceUSD = F(bf().Mul(token0.DerivedETH.Float(), bundle.EthPrice.Float()))
@@ -65,12 +102,125 @@ func (s *Subgraph) createLiquidityPositionSnapshot(position *LiquidityPosition)
 	snapshot.ReserveUSD = pair.ReserveUSD
 	snapshot.LiquidityTokenTotalSupply = pair.TotalSupply
 	snapshot.LiquidityTokenBalance = position.LiquidityTokenBalance
+	snapshot.StakedLiquidityTokenBalance = position.StakedLiquidityTokenBalance
+	snapshot.TotalLiquidityTokenBalance = position.TotalLiquidityTokenBalance
 	snapshot.LiquidityPosition = position.ID
 
+	var previous *LiquidityPositionSnapshot
+	if position.LastSnapshot != nil && *position.LastSnapshot != id {
+		previous = NewLiquidityPositionSnapshot(*position.LastSnapshot)
+		if err := s.Load(previous); err != nil {
+			return fmt.Errorf("loading previous snapshot %s: %w", *position.LastSnapshot, err)
+		}
+
+		if previous.Exists() {
+			snapshot.PreviousSnapshot = &previous.ID
+		} else {
+			previous = nil
+		}
+	}
+	snapshot.computeReturns(previous)
+
 	err = s.Save(snapshot)
 	if err != nil {
 		return err
 	}
 
+	position.LastSnapshot = &snapshot.ID
+	if err := s.Save(position); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// computeReturns sets the underlying amounts and value of the position, and its returns since
+// the `previous` snapshot, nil for the first snapshot of a position.
+func (s *LiquidityPositionSnapshot) computeReturns(previous *LiquidityPositionSnapshot) {
+	// staked tokens are still owned by the user
+	balance := s.TotalLiquidityTokenBalance.Float()
+	share := quoOrZero(balance, s.LiquidityTokenTotalSupply.Float())
+
+	s.Token0Amount = F(bf().Mul(share, s.Reserve0.Float()))
+	s.Token1Amount = F(bf().Mul(share, s.Reserve1.Float()))
+	s.ValueUSD = F(bf().Add(
+		bf().Mul(s.Token0Amount.Float(), s.Token0PriceUSD.Float()),
+		bf().Mul(s.Token1Amount.Float(), s.Token1PriceUSD.Float()),
+	))
+
+	if previous == nil {
+		s.HodlValueUSD = s.ValueUSD
+		s.PnlUSD = FL(0)
+		s.ImpermanentLoss = FL(0)
+		s.FeesAccruedUSD = FL(0)
+		return
+	}
+
+	// underlying amounts of one liquidity token at the previous snapshot
+	previousToken0PerLiquidity := quoOrZero(previous.Reserve0.Float(), previous.LiquidityTokenTotalSupply.Float())
+	previousToken1PerLiquidity := quoOrZero(previous.Reserve1.Float(), previous.LiquidityTokenTotalSupply.Float())
+	s.HodlValueUSD = F(bf().Mul(balance, bf().Add(
+		bf().Mul(previousToken0PerLiquidity, s.Token0PriceUSD.Float()),
+		bf().Mul(previousToken1PerLiquidity, s.Token1PriceUSD.Float()),
+	)))
+	s.PnlUSD = F(bf().Sub(s.ValueUSD.Float(), s.HodlValueUSD.Float()))
+
+	// for a constant product pool, the loss for a price ratio change k is 2 * sqrt(k) / (1 + k) - 1
+	s.ImpermanentLoss = FL(0)
+	priceRatio := quoOrZero(
+		quoOrZero(s.Reserve1.Float(), s.Reserve0.Float()),
+		quoOrZero(previous.Reserve1.Float(), previous.Reserve0.Float()),
+	)
+	if priceRatio.Sign() > 0 {
+		s.ImpermanentLoss = F(bf().Sub(
+			bf().Quo(bf().Mul(big.NewFloat(2), bf().Sqrt(priceRatio)), bf().Add(big.NewFloat(1), priceRatio)),
+			big.NewFloat(1),
+		))
+	}
+
+	// fees make the constant product of one liquidity token grow, the growth since the previous
+	// snapshot is the share of the current value earned as fees
+	s.FeesAccruedUSD = FL(0)
+	growth := quoOrZero(
+		liquidityTokenProduct(s.Reserve0.Float(), s.Reserve1.Float(), s.LiquidityTokenTotalSupply.Float()),
+		liquidityTokenProduct(previous.Reserve0.Float(), previous.Reserve1.Float(), previous.LiquidityTokenTotalSupply.Float()),
+	)
+	if growth.Cmp(big.NewFloat(1)) > 0 {
+		s.FeesAccruedUSD = F(bf().Mul(s.ValueUSD.Float(), bf().Sub(big.NewFloat(1), bf().Quo(big.NewFloat(1), growth))))
+	}
+}
+
+// liquidityTokenProduct returns sqrt(reserve0 * reserve1) / totalSupply, zero for an empty pool.
+func liquidityTokenProduct(reserve0, reserve1, totalSupply *big.Float) *big.Float {
+	product := bf().Mul(reserve0, reserve1)
+	if product.Sign() <= 0 {
+		return bf()
+	}
+
+	return quoOrZero(bf().Sqrt(product), totalSupply)
+}
+
+func quoOrZero(a, b *big.Float) *big.Float {
+	if b.Sign() == 0 {
+		return bf()
+	}
+
+	return bf().Quo(a, b)
+}
+
+// liquidityProviderCountDelta returns the change to apply to a pair's liquidity provider
+// count when a position balance goes from `before` to `after`. Only the delta is returned
+// since the count is summed across parallel shards.
+func liquidityProviderCountDelta(before, after *big.Float) int64 {
+	wasProviding := before.Sign() > 0
+	isProviding := after.Sign() > 0
+
+	switch {
+	case !wasProviding && isProviding:
+		return 1
+	case wasProviding && !isProviding:
+		return -1
+	}
+
+	return 0
+}
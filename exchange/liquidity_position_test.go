@@ -0,0 +1,215 @@
+package exchange
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/sparkle/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiquidityProviderCountDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   float64
+		after    float64
+		expected int64
+	}{
+		{"opening", 0, 1, 1},
+		{"closing", 1, 0, -1},
+		{"increasing", 1, 2, 0},
+		{"decreasing", 2, 1, 0},
+		{"staying empty", 0, 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, liquidityProviderCountDelta(big.NewFloat(test.before), big.NewFloat(test.after)))
+		})
+	}
+}
+
+// testBaseEvent is an event of a transaction in block `blockNum`.
+func testBaseEvent(blockNum uint64) *entity.BaseEvent {
+	return &entity.BaseEvent{
+		Block:       &entity.Block{Number: blockNum},
+		Transaction: &entity.Transaction{Hash: eth.MustNewHash("0x" + strings.Repeat("ab", 32))},
+	}
+}
+
+// testLiquidityUnits returns `amount` liquidity tokens in their base unit.
+func testLiquidityUnits(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+}
+
+// newTestLiquiditySubgraph returns a subgraph at `step` holding the pair of tokens A and B.
+func newTestLiquiditySubgraph(t *testing.T, step int) *Subgraph {
+	intrinsics := NewTestIntrinsics(nil)
+	intrinsics.step = step
+	s := NewTestSubgraph(intrinsics)
+
+	pair := NewPair(testPairAB)
+	pair.Token0, pair.Token1 = testTokenA, testTokenB
+	require.NoError(t, s.Save(pair))
+	require.NoError(t, s.Save(NewToken(testTokenA)))
+	require.NoError(t, s.Save(NewToken(testTokenB)))
+
+	return s
+}
+
+func TestSubgraph_HandlePairTransferEvent_liquidityProviders(t *testing.T) {
+	alice := eth.MustNewAddress("0x00000000000000000000000000000000000000f1")
+	bob := eth.MustNewAddress("0x00000000000000000000000000000000000000f2")
+
+	transfers := []*PairTransferEvent{
+		{From: alice, To: bob, Value: testLiquidityUnits(4)},
+		{From: alice, To: bob, Value: testLiquidityUnits(6)},
+		{From: bob, To: alice, Value: testLiquidityUnits(1)},
+	}
+
+	tests := []struct {
+		name          string
+		step          int
+		expectedCount int64
+	}{
+		// balances are shard local at step 3, counts only follow them from step 4
+		{"balances step", 3, 1},
+		{"final step", 99999, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestLiquiditySubgraph(t, test.step)
+
+			// alice holds 10 tokens, as merged from previous shards
+			position, err := s.createLiquidityPosition(alice, eth.MustNewAddress(testPairAB))
+			require.NoError(t, err)
+			position.LiquidityTokenBalance = FL(10)
+			position.updateTotalBalance()
+			require.NoError(t, s.Save(position))
+
+			pair := NewPair(testPairAB)
+			require.NoError(t, s.Load(pair))
+			pair.LiquidityProviderCount = IL(1)
+			require.NoError(t, s.Save(pair))
+
+			for i, transfer := range transfers {
+				transfer.BaseEvent = testBaseEvent(10)
+				transfer.LogAddress = eth.MustNewAddress(testPairAB)
+				transfer.LogIndex = i
+				require.NoError(t, s.HandlePairTransferEvent(transfer))
+			}
+
+			require.NoError(t, s.Load(pair))
+			assert.Equal(t, test.expectedCount, pair.LiquidityProviderCount.Int().Int64())
+
+			for address, expected := range map[string]string{alice.Pretty(): "1", bob.Pretty(): "9"} {
+				position := NewLiquidityPosition(testPairAB + "-" + address)
+				require.NoError(t, s.Load(position))
+				assert.Equal(t, expected, position.LiquidityTokenBalance.Float().Text('f', -1))
+				assert.Equal(t, expected, position.TotalLiquidityTokenBalance.Float().Text('f', -1))
+			}
+		})
+	}
+}
+
+// testPoolState is the state of a pool at a snapshot, `balance` being the total balance of the
+// position, staked or not.
+type testPoolState struct {
+	reserve0, reserve1 float64
+	totalSupply        float64
+	price0, price1     float64
+	balance            float64
+}
+
+func (p testPoolState) snapshot() *LiquidityPositionSnapshot {
+	snapshot := NewLiquidityPositionSnapshot("snapshot")
+	snapshot.Reserve0 = FL(p.reserve0)
+	snapshot.Reserve1 = FL(p.reserve1)
+	snapshot.LiquidityTokenTotalSupply = FL(p.totalSupply)
+	snapshot.Token0PriceUSD = FL(p.price0)
+	snapshot.Token1PriceUSD = FL(p.price1)
+	snapshot.TotalLiquidityTokenBalance = FL(p.balance)
+
+	return snapshot
+}
+
+func TestLiquidityPositionSnapshot_computeReturns(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous *testPoolState
+		current  testPoolState
+
+		expectedToken0Amount    float64
+		expectedToken1Amount    float64
+		expectedValueUSD        float64
+		expectedHodlValueUSD    float64
+		expectedPnlUSD          float64
+		expectedImpermanentLoss float64
+		expectedFeesAccruedUSD  float64
+	}{
+		{
+			name:                 "first snapshot",
+			current:              testPoolState{1000, 2000, 100, 1, 0.5, 10},
+			expectedToken0Amount: 100,
+			expectedToken1Amount: 200,
+			expectedValueUSD:     200,
+			expectedHodlValueUSD: 200,
+		},
+		{
+			name:                    "price moved",
+			previous:                &testPoolState{1000, 1000, 100, 1, 1, 10},
+			current:                 testPoolState{500, 2000, 100, 4, 1, 10},
+			expectedToken0Amount:    50,
+			expectedToken1Amount:    200,
+			expectedValueUSD:        400,
+			expectedHodlValueUSD:    500,
+			expectedPnlUSD:          -100,
+			expectedImpermanentLoss: -0.2,
+		},
+		{
+			name:                   "fees earned",
+			previous:               &testPoolState{1000, 1000, 100, 1, 1, 10},
+			current:                testPoolState{1100, 1100, 100, 1, 1, 10},
+			expectedToken0Amount:   110,
+			expectedToken1Amount:   110,
+			expectedValueUSD:       220,
+			expectedHodlValueUSD:   200,
+			expectedPnlUSD:         20,
+			expectedFeesAccruedUSD: 20,
+		},
+		{
+			name:     "pool emptied",
+			previous: &testPoolState{1000, 1000, 100, 1, 1, 10},
+			current:  testPoolState{0, 0, 0, 1, 1, 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var previous *LiquidityPositionSnapshot
+			if test.previous != nil {
+				previous = test.previous.snapshot()
+			}
+
+			snapshot := test.current.snapshot()
+			snapshot.computeReturns(previous)
+
+			value := func(f *big.Float) float64 {
+				v, _ := f.Float64()
+				return v
+			}
+
+			assert.InDelta(t, test.expectedToken0Amount, value(snapshot.Token0Amount.Float()), 1e-9)
+			assert.InDelta(t, test.expectedToken1Amount, value(snapshot.Token1Amount.Float()), 1e-9)
+			assert.InDelta(t, test.expectedValueUSD, value(snapshot.ValueUSD.Float()), 1e-9)
+			assert.InDelta(t, test.expectedHodlValueUSD, value(snapshot.HodlValueUSD.Float()), 1e-9)
+			assert.InDelta(t, test.expectedPnlUSD, value(snapshot.PnlUSD.Float()), 1e-9)
+			assert.InDelta(t, test.expectedImpermanentLoss, value(snapshot.ImpermanentLoss.Float()), 1e-9)
+			assert.InDelta(t, test.expectedFeesAccruedUSD, value(snapshot.FeesAccruedUSD.Float()), 1e-9)
+		})
+	}
+}
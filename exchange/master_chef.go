@@ -0,0 +1,232 @@
+package exchange
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	eth "github.com/streamingfast/eth-go"
+	"github.com/streamingfast/sparkle/entity"
+	pbcodec "github.com/streamingfast/sparkle/pb/dfuse/ethereum/codec/v1"
+)
+
+// Events of the `MasterChef` data source, written after the generated ones, see
+// `datasources.go`.
+
+func decodeMasterChefEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (interface{}, error) {
+	if IsMasterChefDepositEvent(log) {
+		ev, err := NewMasterChefDepositEvent(log, block, trace)
+		if err != nil {
+			return nil, fmt.Errorf("decoding MasterChefDeposit event: %w", err)
+		}
+		return ev, nil
+	}
+
+	if IsMasterChefWithdrawEvent(log) {
+		ev, err := NewMasterChefWithdrawEvent(log, block, trace)
+		if err != nil {
+			return nil, fmt.Errorf("decoding MasterChefWithdraw event: %w", err)
+		}
+		return ev, nil
+	}
+
+	if IsMasterChefEmergencyWithdrawEvent(log) {
+		ev, err := NewMasterChefEmergencyWithdrawEvent(log, block, trace)
+		if err != nil {
+			return nil, fmt.Errorf("decoding MasterChefEmergencyWithdraw event: %w", err)
+		}
+		return ev, nil
+	}
+
+	return nil, nil
+}
+
+// decodeMasterChefLog reads the fields shared by every MasterChef event.
+func decodeMasterChefLog(log *eth.Log) (user eth.Address, pid *big.Int, amount *big.Int, err error) {
+	dec := eth.NewLogDecoder(log)
+	if _, err := dec.ReadTopic(); err != nil {
+		return nil, nil, nil, fmt.Errorf("reading topic 0: %w", err)
+	}
+	f0, err := dec.ReadTypedTopic("address")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("reading user: %w", err)
+	}
+	f1, err := dec.ReadTypedTopic("uint256")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("reading pid: %w", err)
+	}
+	amount, err = dec.DataDecoder.ReadBigInt()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("reading amount: %w", err)
+	}
+	return f0.(eth.Address), f1.(*big.Int), amount, nil
+}
+
+// findStakedToken returns the token of the transfer between `user` and the MasterChef contract
+// of `amount` preceding `log` in `trace`, deposits moving tokens to the contract and withdrawals
+// from it.
+func findStakedToken(log *eth.Log, trace *pbcodec.TransactionTrace, user eth.Address, amount *big.Int, deposit bool) eth.Address {
+	// deposits and withdrawals of zero harvest the pending rewards without moving any liquidity
+	// token, there is no transfer to match and the stake amount does not change
+	if amount.Sign() == 0 {
+		return nil
+	}
+
+	from, to := log.Address, user
+	if deposit {
+		from, to = user, log.Address
+	}
+
+	var token eth.Address
+	for _, transfer := range trace.Logs() {
+		if transfer.Index >= log.Index {
+			break
+		}
+		if len(transfer.Topics) != 3 || !bytes.Equal(transfer.Topics[0], hashPairTransferEvent) {
+			continue
+		}
+		// SUSHI rewards are transferred along deposits and withdrawals
+		if eth.Address(transfer.Address).Pretty() == network.SushiTokenAddress {
+			continue
+		}
+		if !bytes.Equal(transfer.Topics[1][12:], from) || !bytes.Equal(transfer.Topics[2][12:], to) {
+			continue
+		}
+		if new(big.Int).SetBytes(transfer.Data).Cmp(amount) != 0 {
+			continue
+		}
+
+		token = transfer.Address
+	}
+
+	return token
+}
+
+// MasterChefDeposit event
+
+type MasterChefDepositEvent struct {
+	*entity.BaseEvent
+	LogAddress eth.Address
+	LogIndex   int
+
+	// Fields
+	User   eth.Address `eth:",indexed"`
+	Pid    *big.Int    `eth:",indexed"`
+	Amount *big.Int    `eth:""`
+
+	// LiquidityToken is the token moved by the event, found from the transfers of the
+	// transaction, nil when there is none
+	LiquidityToken eth.Address
+}
+
+var hashMasterChefDepositEvent = eth.Keccak256([]byte("Deposit(address,uint256,uint256)"))
+
+func IsMasterChefDepositEvent(log *eth.Log) bool {
+	return bytes.Equal(log.Topics[0], hashMasterChefDepositEvent)
+}
+
+func NewMasterChefDepositEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (*MasterChefDepositEvent, error) {
+	ev := &MasterChefDepositEvent{
+		BaseEvent:  &entity.BaseEvent{},
+		LogAddress: log.Address,
+		LogIndex:   int(log.BlockIndex),
+	}
+
+	ev.SetBlockAndTransaction(block, trace)
+
+	user, pid, amount, err := decodeMasterChefLog(log)
+	if err != nil {
+		return nil, err
+	}
+	ev.User = user
+	ev.Pid = pid
+	ev.Amount = amount
+	ev.LiquidityToken = findStakedToken(log, trace, user, amount, true)
+	return ev, nil
+}
+
+// MasterChefWithdraw event
+
+type MasterChefWithdrawEvent struct {
+	*entity.BaseEvent
+	LogAddress eth.Address
+	LogIndex   int
+
+	// Fields
+	User   eth.Address `eth:",indexed"`
+	Pid    *big.Int    `eth:",indexed"`
+	Amount *big.Int    `eth:""`
+
+	// LiquidityToken is the token moved by the event, found from the transfers of the
+	// transaction, nil when there is none
+	LiquidityToken eth.Address
+}
+
+var hashMasterChefWithdrawEvent = eth.Keccak256([]byte("Withdraw(address,uint256,uint256)"))
+
+func IsMasterChefWithdrawEvent(log *eth.Log) bool {
+	return bytes.Equal(log.Topics[0], hashMasterChefWithdrawEvent)
+}
+
+func NewMasterChefWithdrawEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (*MasterChefWithdrawEvent, error) {
+	ev := &MasterChefWithdrawEvent{
+		BaseEvent:  &entity.BaseEvent{},
+		LogAddress: log.Address,
+		LogIndex:   int(log.BlockIndex),
+	}
+
+	ev.SetBlockAndTransaction(block, trace)
+
+	user, pid, amount, err := decodeMasterChefLog(log)
+	if err != nil {
+		return nil, err
+	}
+	ev.User = user
+	ev.Pid = pid
+	ev.Amount = amount
+	ev.LiquidityToken = findStakedToken(log, trace, user, amount, false)
+	return ev, nil
+}
+
+// MasterChefEmergencyWithdraw event
+
+type MasterChefEmergencyWithdrawEvent struct {
+	*entity.BaseEvent
+	LogAddress eth.Address
+	LogIndex   int
+
+	// Fields
+	User   eth.Address `eth:",indexed"`
+	Pid    *big.Int    `eth:",indexed"`
+	Amount *big.Int    `eth:""`
+
+	// LiquidityToken is the token moved by the event, found from the transfers of the
+	// transaction, nil when there is none
+	LiquidityToken eth.Address
+}
+
+var hashMasterChefEmergencyWithdrawEvent = eth.Keccak256([]byte("EmergencyWithdraw(address,uint256,uint256)"))
+
+func IsMasterChefEmergencyWithdrawEvent(log *eth.Log) bool {
+	return bytes.Equal(log.Topics[0], hashMasterChefEmergencyWithdrawEvent)
+}
+
+func NewMasterChefEmergencyWithdrawEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (*MasterChefEmergencyWithdrawEvent, error) {
+	ev := &MasterChefEmergencyWithdrawEvent{
+		BaseEvent:  &entity.BaseEvent{},
+		LogAddress: log.Address,
+		LogIndex:   int(log.BlockIndex),
+	}
+
+	ev.SetBlockAndTransaction(block, trace)
+
+	user, pid, amount, err := decodeMasterChefLog(log)
+	if err != nil {
+		return nil, err
+	}
+	ev.User = user
+	ev.Pid = pid
+	ev.Amount = amount
+	ev.LiquidityToken = findStakedToken(log, trace, user, amount, false)
+	return ev, nil
+}
@@ -0,0 +1,181 @@
+package exchange
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbcodec "github.com/streamingfast/sparkle/pb/dfuse/ethereum/codec/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTransferLog is a `Transfer` of `amount` of `token` at `index` in the transaction.
+func testTransferLog(index uint32, token string, from, to eth.Address, amount int64) *pbcodec.Log {
+	topic := func(address eth.Address) []byte {
+		return append(make([]byte, 12), address...)
+	}
+
+	return &pbcodec.Log{
+		Address: eth.MustNewAddress(token),
+		Topics:  [][]byte{hashPairTransferEvent, topic(from), topic(to)},
+		Data:    new(big.Int).SetInt64(amount).FillBytes(make([]byte, 32)),
+		Index:   index,
+	}
+}
+
+func TestFindStakedToken(t *testing.T) {
+	masterChef := eth.MustNewAddress(network.MasterChefAddress)
+	user := eth.MustNewAddress("0x00000000000000000000000000000000000000f1")
+
+	tests := []struct {
+		name     string
+		logs     []*pbcodec.Log
+		amount   int64
+		deposit  bool
+		expected string
+	}{
+		{
+			name:     "deposit",
+			logs:     []*pbcodec.Log{testTransferLog(0, testPairAB, user, masterChef, 5)},
+			amount:   5,
+			deposit:  true,
+			expected: testPairAB,
+		},
+		{
+			name: "withdraw along rewards",
+			logs: []*pbcodec.Log{
+				testTransferLog(0, network.SushiTokenAddress, masterChef, user, 5),
+				testTransferLog(1, testPairAB, masterChef, user, 5),
+			},
+			amount:   5,
+			expected: testPairAB,
+		},
+		{
+			// a harvest moves no liquidity token, only rewards
+			name:   "zero amount harvest",
+			logs:   []*pbcodec.Log{testTransferLog(0, network.SushiTokenAddress, masterChef, user, 7)},
+			amount: 0,
+		},
+		{
+			name:    "other amount",
+			logs:    []*pbcodec.Log{testTransferLog(0, testPairAB, user, masterChef, 4)},
+			amount:  5,
+			deposit: true,
+		},
+		{
+			name:    "transfer after the event",
+			logs:    []*pbcodec.Log{testTransferLog(3, testPairAB, user, masterChef, 5)},
+			amount:  5,
+			deposit: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			log := &eth.Log{Address: masterChef, Index: 2}
+			trace := &pbcodec.TransactionTrace{Calls: []*pbcodec.Call{{Logs: test.logs}}}
+
+			token := findStakedToken(log, trace, user, big.NewInt(test.amount), test.deposit)
+			if test.expected == "" {
+				assert.Nil(t, token)
+				return
+			}
+
+			assert.Equal(t, test.expected, token.Pretty())
+		})
+	}
+}
+
+func TestSubgraph_updateMasterChefStake(t *testing.T) {
+	user := eth.MustNewAddress("0x00000000000000000000000000000000000000f1")
+	pid := big.NewInt(1)
+	pairAB, pairAC := eth.MustNewAddress(testPairAB), eth.MustNewAddress(testPairAC)
+
+	type update struct {
+		token eth.Address
+		delta float64
+	}
+
+	tests := []struct {
+		name           string
+		step           int
+		updates        []update
+		expectedAmount string
+		// staked balance and liquidity provider count per pair, nil when no position exists
+		expectedStaked map[string]string
+		expectedCounts map[string]int64
+	}{
+		{
+			name:           "amounts step",
+			step:           2,
+			updates:        []update{{pairAB, 10}},
+			expectedAmount: "10",
+			expectedStaked: map[string]string{testPairAB: "", testPairAC: ""},
+			expectedCounts: map[string]int64{testPairAB: 0, testPairAC: 0},
+		},
+		{
+			name:           "balances step",
+			step:           3,
+			updates:        []update{{pairAB, 10}},
+			expectedAmount: "10",
+			expectedStaked: map[string]string{testPairAB: "10", testPairAC: ""},
+			expectedCounts: map[string]int64{testPairAB: 0, testPairAC: 0},
+		},
+		{
+			name:           "deposit and harvest",
+			step:           99999,
+			updates:        []update{{pairAB, 10}, {nil, 0}, {pairAB, -4}},
+			expectedAmount: "6",
+			expectedStaked: map[string]string{testPairAB: "6", testPairAC: ""},
+			expectedCounts: map[string]int64{testPairAB: 1, testPairAC: 0},
+		},
+		{
+			name:           "migrated pool",
+			step:           99999,
+			updates:        []update{{pairAB, 10}, {pairAC, -4}},
+			expectedAmount: "6",
+			expectedStaked: map[string]string{testPairAB: "0", testPairAC: "6"},
+			expectedCounts: map[string]int64{testPairAB: 0, testPairAC: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestLiquiditySubgraph(t, test.step)
+
+			pair := NewPair(testPairAC)
+			pair.Factory = network.FactoryAddress
+			pair.Token0, pair.Token1 = testTokenA, testTokenC
+			require.NoError(t, s.Save(pair))
+			require.NoError(t, s.Save(NewToken(testTokenC)))
+
+			for i, u := range test.updates {
+				require.NoError(t, s.updateMasterChefStake(user, pid, u.token, i, big.NewFloat(u.delta)))
+			}
+
+			stake := NewMasterChefStake("1-" + user.Pretty())
+			require.NoError(t, s.Load(stake))
+			assert.Equal(t, test.expectedAmount, stake.Amount.Float().Text('f', -1))
+
+			for pairAddress, expected := range test.expectedStaked {
+				position := NewLiquidityPosition(liquidityPositionID(pairAddress, user.Pretty()))
+				require.NoError(t, s.Load(position))
+				if expected == "" {
+					assert.False(t, position.Exists(), pairAddress)
+					continue
+				}
+
+				require.True(t, position.Exists(), pairAddress)
+				assert.Equal(t, expected, position.StakedLiquidityTokenBalance.Float().Text('f', -1), pairAddress)
+				assert.Equal(t, expected, position.TotalLiquidityTokenBalance.Float().Text('f', -1), pairAddress)
+			}
+
+			for pairAddress, expected := range test.expectedCounts {
+				pair := NewPair(pairAddress)
+				require.NoError(t, s.Load(pair))
+				assert.Equal(t, expected, pair.LiquidityProviderCount.Int().Int64(), pairAddress)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package exchange
+
+import (
+	"math/big"
+
+	"github.com/streamingfast/sparkle/entity"
+)
+
+// candleMerger is implemented by entities holding open/high/low/close values. The generated
+// merge keeps the latest shard values, which is only right for the close: the open must come
+// from the earliest shard and the high/low combine every shard. The candles of the pair hour,
+// day and interval data are merged through their `bucketFields`.
+type candleMerger interface {
+	mergeCandles(cached entity.Interface)
+}
+
+// aprUpdater is implemented by entities whose APR derives from summed fields, it must be
+// recomputed once the sums are merged.
+type aprUpdater interface {
+	updateAPR()
+}
+
+func init() {
+	generatedMergeFunc := Definition.MergeFunc
+	Definition.MergeFunc = func(step int, cached, next entity.Interface) entity.Interface {
+		merged := generatedMergeFunc(step, cached, next)
+		if cached == nil || step != Definition.HighestParallelStep+1 {
+			return merged
+		}
+
+		if candles, ok := merged.(candleMerger); ok {
+			candles.mergeCandles(cached)
+		}
+
+		if data, ok := merged.(pairBucketData); ok {
+			fields, cachedFields := data.bucketFields(), cached.(pairBucketData).bucketFields()
+			fields.Token0Price.merge(cachedFields.Token0Price)
+			fields.Token1Price.merge(cachedFields.Token1Price)
+			fields.Token0PriceUSD.merge(cachedFields.Token0PriceUSD)
+			fields.Token1PriceUSD.merge(cachedFields.Token1PriceUSD)
+		}
+
+		if pair, ok := merged.(*Pair); ok && pair.MutatedOnStep == Definition.HighestParallelStep {
+			// the generated merge keeps the APR window of the latest shard only
+			pair.mergeAPRWindow(cached.(*Pair))
+		}
+
+		if user, ok := merged.(*User); ok {
+			// a user seen by several shards is created in each of them
+			if first := cached.(*User).FirstSeenBlock; first != 0 && (user.FirstSeenBlock == 0 || first < user.FirstSeenBlock) {
+				user.FirstSeenBlock = first
+			}
+		}
+
+		if apr, ok := merged.(aprUpdater); ok {
+			apr.updateAPR()
+		}
+
+		return merged
+	}
+}
+
+func (next *EthPriceHourData) mergeCandles(cached entity.Interface) {
+	c := cached.(*EthPriceHourData)
+	mergeCandle(&next.Open, &next.High, &next.Low, &next.Close, c.Open, c.High, c.Low, c.Close)
+}
+
+func (next *EthPriceDayData) mergeCandles(cached entity.Interface) {
+	c := cached.(*EthPriceDayData)
+	mergeCandle(&next.Open, &next.High, &next.Low, &next.Close, c.Open, c.High, c.Low, c.Close)
+}
+
+// mergeCandle folds the candle of an earlier shard into the one of a later shard, a zero open
+// meaning a shard did not see any price for the period.
+func mergeCandle(open, high, low, close *entity.Float, cachedOpen, cachedHigh, cachedLow, cachedClose entity.Float) {
+	if cachedOpen.Float().Sign() == 0 {
+		return
+	}
+
+	if open.Float().Sign() == 0 {
+		*close = cachedClose
+	}
+
+	*open = cachedOpen
+	*high = F(maxFloat(high.Float(), cachedHigh.Float()))
+	*low = F(minFloat(low.Float(), cachedLow.Float()))
+}
+
+// updateCandle moves a candle with `price`, zero prices are ignored as they stand for a price
+// that cannot be computed.
+func updateCandle(open, high, low, close *entity.Float, price *big.Float) {
+	if price.Sign() == 0 {
+		return
+	}
+
+	if open.Float().Sign() == 0 {
+		*open = F(price)
+	}
+
+	*high = F(maxFloat(high.Float(), price))
+	*low = F(minFloat(low.Float(), price))
+	*close = F(price)
+}
+
+func maxFloat(a, b *big.Float) *big.Float {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// minFloat ignores zero values, which stand for a price that was never set.
+func minFloat(a, b *big.Float) *big.Float {
+	if a.Sign() == 0 {
+		return b
+	}
+	if b.Sign() == 0 || a.Cmp(b) <= 0 {
+		return a
+	}
+	return b
+}
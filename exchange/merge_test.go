@@ -0,0 +1,74 @@
+package exchange
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/streamingfast/sparkle/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+// testCandle is an open, high, low and close candle, zero standing for a price never set.
+type testCandle [4]float64
+
+func (c testCandle) fields() (open, high, low, close *entity.Float) {
+	o, h, l, cl := FL(c[0]), FL(c[1]), FL(c[2]), FL(c[3])
+	return &o, &h, &l, &cl
+}
+
+func candleValues(open, high, low, close *entity.Float) testCandle {
+	var values testCandle
+	for i, field := range []*entity.Float{open, high, low, close} {
+		values[i], _ = field.Float().Float64()
+	}
+
+	return values
+}
+
+func TestUpdateCandle(t *testing.T) {
+	tests := []struct {
+		name     string
+		candle   testCandle
+		price    float64
+		expected testCandle
+	}{
+		{"first price", testCandle{}, 2, testCandle{2, 2, 2, 2}},
+		{"new high", testCandle{2, 3, 1, 2}, 4, testCandle{2, 4, 1, 4}},
+		{"new low", testCandle{2, 3, 1, 2}, 0.5, testCandle{2, 3, 0.5, 0.5}},
+		{"within range", testCandle{2, 3, 1, 2}, 1.5, testCandle{2, 3, 1, 1.5}},
+		{"zero price ignored", testCandle{2, 3, 1, 2}, 0, testCandle{2, 3, 1, 2}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			open, high, low, close := test.candle.fields()
+			updateCandle(open, high, low, close, big.NewFloat(test.price))
+
+			assert.Equal(t, test.expected, candleValues(open, high, low, close))
+		})
+	}
+}
+
+func TestMergeCandle(t *testing.T) {
+	tests := []struct {
+		name     string
+		next     testCandle
+		cached   testCandle
+		expected testCandle
+	}{
+		{"both set", testCandle{3, 5, 2, 4}, testCandle{2, 3, 1, 3}, testCandle{2, 5, 1, 4}},
+		{"next higher low", testCandle{3, 5, 2, 4}, testCandle{2, 6, 2.5, 3}, testCandle{2, 6, 2, 4}},
+		{"next unset", testCandle{}, testCandle{2, 3, 1, 3}, testCandle{2, 3, 1, 3}},
+		{"cached unset", testCandle{3, 5, 2, 4}, testCandle{}, testCandle{3, 5, 2, 4}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			open, high, low, close := test.next.fields()
+			cachedOpen, cachedHigh, cachedLow, cachedClose := test.cached.fields()
+			mergeCandle(open, high, low, close, *cachedOpen, *cachedHigh, *cachedLow, *cachedClose)
+
+			assert.Equal(t, test.expected, candleValues(open, high, low, close))
+		})
+	}
+}
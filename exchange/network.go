@@ -0,0 +1,366 @@
+package exchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/streamingfast/eth-go"
+
+	"go.uber.org/zap"
+)
+
+// NetworkProfile holds every chain specific value the exchange handlers depend on. The active
+// profile defaults to `mainnet` and can be swapped at startup through `ConfigureNetwork`.
+type NetworkProfile struct {
+	Name           string `json:"name"`
+	FactoryAddress string `json:"factory_address"`
+	StartBlock     uint64 `json:"start_block"`
+
+	// AdditionalFactories are V2 compatible factories indexed along `FactoryAddress`, each one
+	// gets its own `Factory`, `DayData` and `HourData` entities while tokens are shared
+	AdditionalFactories []*AdditionalFactory `json:"additional_factories"`
+
+	// Wrapped native token (WETH on mainnet), every derived price is expressed against it
+	NativeAddress string `json:"native_address"`
+
+	// Stablecoin/native pairs used to compute the native token price in USD
+	EthPriceReferences []*ReferencePair `json:"eth_price_references"`
+	// EthPriceSources lists, by order of preference, sets of reference pair addresses, the first
+	// set whose pairs are all liquid enough prices the native token. When empty, every liquid
+	// reference pair is used.
+	EthPriceSources [][]string `json:"eth_price_sources"`
+	// EthPriceEstimator selects how the prices of a source are combined, one of
+	// `weighted_average` (default) or `weighted_median`, weights being the pairs native reserve
+	EthPriceEstimator string `json:"eth_price_estimator"`
+
+	// Whitelist is a slice because we need to respect the order when using it in certain location, so
+	// we must not converted to a map[string]bool directly unless there is a strict ordering way to list them.
+	Whitelist []string `json:"whitelist"`
+	Blacklist []string `json:"blacklist"`
+
+	// DistributorAddress is the SUSHI merkle distributor whose claims are indexed, optional
+	DistributorAddress string `json:"distributor_address"`
+
+	// SushiTokenAddress is the SUSHI token whose holders and delegates are indexed, optional.
+	// Indexing starts at `SushiTokenStartBlock` when it is before `StartBlock`.
+	SushiTokenAddress    string `json:"sushi_token_address"`
+	SushiTokenStartBlock uint64 `json:"sushi_token_start_block"`
+	// SushiNonCirculating lists the addresses whose SUSHI is excluded from the circulating supply
+	SushiNonCirculating []string `json:"sushi_non_circulating"`
+
+	// MasterChefAddress is the staking contract whose liquidity token deposits are indexed, optional
+	MasterChefAddress string `json:"master_chef_address"`
+
+	// PricingMode selects how whitelisted pairs derive token prices, one of `first_match`
+	// (default) or `liquidity_weighted`
+	PricingMode string `json:"pricing_mode"`
+}
+
+const (
+	// PricingModeFirstMatch uses the first whitelisted pair, in creation order, holding enough liquidity
+	PricingModeFirstMatch = "first_match"
+	// PricingModeLiquidityWeighted averages every whitelisted pair holding enough liquidity, weighted by reserves
+	PricingModeLiquidityWeighted = "liquidity_weighted"
+)
+
+const (
+	EthPriceEstimatorWeightedAverage = "weighted_average"
+	EthPriceEstimatorWeightedMedian  = "weighted_median"
+)
+
+// ReferencePair is a pair used as a pricing reference, `Token` being the side of the pair
+// whose price is known (the stablecoin for USD references).
+type ReferencePair struct {
+	Pair  string `json:"pair"`
+	Token string `json:"token"`
+
+	// MinimumLiquidityEth overrides `MinimumLiquidityThresholdEth` for this pair when set
+	MinimumLiquidityEth float64 `json:"minimum_liquidity_eth,omitempty"`
+}
+
+// AdditionalFactory is a factory indexed along the main one of a network.
+type AdditionalFactory struct {
+	Address    string `json:"address"`
+	StartBlock uint64 `json:"start_block"`
+}
+
+func (r *ReferencePair) minimumLiquidityEth() *big.Float {
+	if r.MinimumLiquidityEth > 0 {
+		return big.NewFloat(r.MinimumLiquidityEth)
+	}
+
+	return MinimumLiquidityThresholdEth
+}
+
+var network = MainnetProfile
+
+const (
+	mainnetDaiWethPair  = "0xc3d03e4f041fd4cd388c549ee2a29a9e5075882f"
+	mainnetUsdcWethPair = "0x397ff1542f962076d0bfe58ea045ffa2d347aca0"
+	mainnetUsdtWethPair = "0x06da0fd433c1a5d7a4faa01111c044910a184553"
+
+	mainnetDistributor = "0xcbe6b83e77cdc011cc18f6f0df8444e5783ed982"
+	mainnetMasterChef  = "0xc2edad668740f1aa35e4d8f227fb8e17dca888cd"
+)
+
+var MainnetProfile = &NetworkProfile{
+	Name:           "mainnet",
+	FactoryAddress: FactoryAddress,
+	StartBlock:     10794229,
+	NativeAddress:  "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+	EthPriceReferences: []*ReferencePair{
+		{Pair: mainnetDaiWethPair, Token: "0x6b175474e89094c44da98b954eedeac495271d0f"},
+		{Pair: mainnetUsdcWethPair, Token: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"},
+		{Pair: mainnetUsdtWethPair, Token: "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+	},
+	EthPriceSources: [][]string{
+		{mainnetDaiWethPair, mainnetUsdcWethPair, mainnetUsdtWethPair},
+		{mainnetDaiWethPair, mainnetUsdcWethPair},
+		{mainnetUsdcWethPair},
+		{mainnetUsdtWethPair},
+		{mainnetDaiWethPair},
+	},
+	EthPriceEstimator: EthPriceEstimatorWeightedAverage,
+	Whitelist: []string{
+		"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+		"0x2260fac5e5542a773aa44fbcfedf7c193bc2c599",
+		"0x6b175474e89094c44da98b954eedeac495271d0f",
+		"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+		"0xdac17f958d2ee523a2206206994597c13d831ec7",
+		"0x0000000000085d4780b73119b644ae5ecd22b376",
+		"0x5d3a536e4d6dbd6114cc1ead35777bab948e3643",
+		"0x57ab1ec28d129707052df4df418d58a2d46d5f51",
+		"0x514910771af9ca656af840dff83e8264ecf986ca",
+		"0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e",
+		"0x8798249c2e607446efb7ad49ec89dd1865ff4272",
+		"0x1456688345527be1f37e9e627da0837d6f08c925",
+		"0x3449fc1cd036255ba1eb19d65ff4ba2b8903a69a",
+		"0x2ba592f78db6436527729929aaf6c908497cb200",
+		"0x3432b6a60d23ca0dfca7761b7ab56459d9c964d0",
+		"0xa1faa113cbe53436df28ff0aee54275c13b40975",
+		"0xdb0f18081b505a7de20b18ac41856bcb4ba86a1a",
+		"0x04fa0d235c4abf4bcf4787af4cf447de572ef828",
+		"0x3155ba85d5f96b2d030a4966af206230e46849cb",
+		"0x87d73e916d7057945c9bcd8cdd94e42a6f47f776",
+		"0xdfe66b14d37c77f4e9b180ceb433d1b164f0281d",
+		"0xad32a8e6220741182940c5abf610bde99e737b2d",
+		"0xafcE9B78D409bF74980CACF610AFB851BF02F257",
+		"0x6b3595068778dd592e39a122f4f5a5cf09c90fe2",
+	},
+	Blacklist: []string{
+		"0x9ea3b5b4ec044b70375236a281986106457b20ef",
+	},
+	DistributorAddress:   mainnetDistributor,
+	SushiTokenAddress:    "0x6b3595068778dd592e39a122f4f5a5cf09c90fe2",
+	SushiTokenStartBlock: 10736242,
+	SushiNonCirculating: []string{
+		mainnetMasterChef,  // rewards not harvested yet
+		mainnetDistributor, // vested rewards not claimed yet
+		"0x9a8541ddf3a932a9a922b607e9cf7301f1d47bd1", // timelock
+		"0xe94b5eec1fa96ceecbd33ef5baa8d00e4493f4f3", // dev and treasury multisig
+	},
+	MasterChefAddress: mainnetMasterChef,
+	PricingMode:       PricingModeFirstMatch,
+}
+
+var networks = map[string]*NetworkProfile{
+	MainnetProfile.Name: MainnetProfile,
+}
+
+// RegisterNetwork adds a profile to the built-in ones so it can be selected by name.
+func RegisterNetwork(profile *NetworkProfile) {
+	networks[profile.Name] = profile
+}
+
+// NetworkNames returns the sorted names of the built-in profiles.
+func NetworkNames() []string {
+	var names []string
+	for name := range networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ConfigureNetwork activates the profile read from `profilePath` when it is set, otherwise
+// the built-in profile named `name`.
+func ConfigureNetwork(name, profilePath string) error {
+	if profilePath != "" {
+		profile, err := LoadNetworkProfile(profilePath)
+		if err != nil {
+			return err
+		}
+
+		return SetNetwork(profile)
+	}
+
+	profile, found := networks[name]
+	if !found {
+		return fmt.Errorf("unknown network %q, valid values are: %s", name, strings.Join(NetworkNames(), ", "))
+	}
+
+	return SetNetwork(profile)
+}
+
+// LoadNetworkProfile reads a JSON encoded network profile.
+func LoadNetworkProfile(path string) (*NetworkProfile, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading network profile %q: %w", path, err)
+	}
+
+	profile := &NetworkProfile{}
+	if err := json.Unmarshal(content, profile); err != nil {
+		return nil, fmt.Errorf("decoding network profile %q: %w", path, err)
+	}
+
+	return profile, nil
+}
+
+// SetNetwork validates and activates `profile`, updating the subgraph definition accordingly.
+func SetNetwork(profile *NetworkProfile) error {
+	if err := profile.normalize(); err != nil {
+		return fmt.Errorf("invalid network profile %q: %w", profile.Name, err)
+	}
+
+	network = profile
+	whitelistCacheMap = map[string]bool{}
+	blacklistCacheMap = map[string]bool{}
+
+	Definition.StartBlock = profile.firstBlock()
+	FactoryAddressBytes = eth.MustNewAddress(profile.FactoryAddress).Bytes()
+
+	zlog.Info("network profile configured",
+		zap.String("name", profile.Name),
+		zap.String("factory", profile.FactoryAddress),
+		zap.Int("additional_factories", len(profile.AdditionalFactories)),
+		zap.Uint64("start_block", Definition.StartBlock),
+	)
+	return nil
+}
+
+// normalize validates the addresses of the profile and converts them to their pretty form,
+// which is the form entities are keyed by.
+func (p *NetworkProfile) normalize() error {
+	var err error
+	if p.FactoryAddress, err = prettyAddress(p.FactoryAddress); err != nil {
+		return fmt.Errorf("factory address: %w", err)
+	}
+	for _, factory := range p.AdditionalFactories {
+		if factory.Address, err = prettyAddress(factory.Address); err != nil {
+			return fmt.Errorf("additional factory address: %w", err)
+		}
+		if factory.Address == p.FactoryAddress {
+			return fmt.Errorf("additional factory %s is the network factory", factory.Address)
+		}
+	}
+	if p.NativeAddress, err = prettyAddress(p.NativeAddress); err != nil {
+		return fmt.Errorf("native address: %w", err)
+	}
+
+	if p.DistributorAddress != "" {
+		if p.DistributorAddress, err = prettyAddress(p.DistributorAddress); err != nil {
+			return fmt.Errorf("distributor address: %w", err)
+		}
+	}
+
+	if p.SushiTokenAddress != "" {
+		if p.SushiTokenAddress, err = prettyAddress(p.SushiTokenAddress); err != nil {
+			return fmt.Errorf("sushi token address: %w", err)
+		}
+	}
+	if p.MasterChefAddress != "" {
+		if p.MasterChefAddress, err = prettyAddress(p.MasterChefAddress); err != nil {
+			return fmt.Errorf("master chef address: %w", err)
+		}
+	}
+	for i, address := range p.SushiNonCirculating {
+		if p.SushiNonCirculating[i], err = prettyAddress(address); err != nil {
+			return fmt.Errorf("sushi non circulating address: %w", err)
+		}
+	}
+
+	switch p.PricingMode {
+	case "":
+		p.PricingMode = PricingModeFirstMatch
+	case PricingModeFirstMatch, PricingModeLiquidityWeighted:
+	default:
+		return fmt.Errorf("unknown pricing mode %q", p.PricingMode)
+	}
+
+	switch p.EthPriceEstimator {
+	case "":
+		p.EthPriceEstimator = EthPriceEstimatorWeightedAverage
+	case EthPriceEstimatorWeightedAverage, EthPriceEstimatorWeightedMedian:
+	default:
+		return fmt.Errorf("unknown eth price estimator %q", p.EthPriceEstimator)
+	}
+
+	references := map[string]bool{}
+	for _, ref := range p.EthPriceReferences {
+		if ref.Pair, err = prettyAddress(ref.Pair); err != nil {
+			return fmt.Errorf("reference pair: %w", err)
+		}
+		if ref.Token, err = prettyAddress(ref.Token); err != nil {
+			return fmt.Errorf("reference pair %s token: %w", ref.Pair, err)
+		}
+		references[ref.Pair] = true
+	}
+
+	for _, source := range p.EthPriceSources {
+		for i, pair := range source {
+			if source[i], err = prettyAddress(pair); err != nil {
+				return fmt.Errorf("eth price source: %w", err)
+			}
+			if !references[source[i]] {
+				return fmt.Errorf("eth price source pair %s is not a reference pair", source[i])
+			}
+		}
+	}
+
+	return nil
+}
+
+// firstBlock is the block indexing starts at, the earliest start block of the indexed contracts.
+func (p *NetworkProfile) firstBlock() uint64 {
+	first := p.StartBlock
+	if p.SushiTokenAddress != "" && p.SushiTokenStartBlock != 0 && p.SushiTokenStartBlock < first {
+		first = p.SushiTokenStartBlock
+	}
+	for _, factory := range p.AdditionalFactories {
+		if factory.StartBlock != 0 && factory.StartBlock < first {
+			first = factory.StartBlock
+		}
+	}
+
+	return first
+}
+
+// factoryDataID is the id of the `DayData` or `HourData` of `factory` for period `periodID`,
+// the data of the network factory keeping the bare period id.
+func factoryDataID(factory string, periodID int64) string {
+	if factory == network.FactoryAddress {
+		return strconv.FormatInt(periodID, 10)
+	}
+
+	return fmt.Sprintf("%s-%d", factory, periodID)
+}
+
+func prettyAddress(address string) (string, error) {
+	if address == "" {
+		return "", fmt.Errorf("address is required")
+	}
+
+	addr, err := eth.NewAddress(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid address %q: %w", address, err)
+	}
+
+	return addr.Pretty(), nil
+}
@@ -0,0 +1,101 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNetworkProfile() *NetworkProfile {
+	return &NetworkProfile{
+		Name:           "test",
+		FactoryAddress: "0xC0AEe478e3658e2610c5F7A4A2E1777cE9e4f2Ac",
+		NativeAddress:  "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+		EthPriceReferences: []*ReferencePair{
+			{Pair: "0xC3D03e4F041Fd4cD388c549Ee2A29a9E5075882f", Token: "0x6B175474E89094C44Da98b954EedeAC495271d0F"},
+		},
+		EthPriceSources:     [][]string{{"0xC3D03e4F041Fd4cD388c549Ee2A29a9E5075882f"}},
+		SushiNonCirculating: []string{"0xC2EdaD668740f1aA35E4D8f227fB8E17dcA888Cd"},
+	}
+}
+
+func TestNetworkProfile_normalize(t *testing.T) {
+	tests := []struct {
+		name          string
+		update        func(p *NetworkProfile)
+		expectedError string
+	}{
+		{
+			name:   "valid",
+			update: func(p *NetworkProfile) {},
+		},
+		{
+			name:          "missing factory",
+			update:        func(p *NetworkProfile) { p.FactoryAddress = "" },
+			expectedError: "factory address: address is required",
+		},
+		{
+			name:          "invalid native address",
+			update:        func(p *NetworkProfile) { p.NativeAddress = "0xzz" },
+			expectedError: "native address: invalid address",
+		},
+		{
+			name:          "invalid reference token",
+			update:        func(p *NetworkProfile) { p.EthPriceReferences[0].Token = "0xzz" },
+			expectedError: "reference pair 0xc3d03e4f041fd4cd388c549ee2a29a9e5075882f token: invalid address",
+		},
+		{
+			name: "additional factory is the network factory",
+			update: func(p *NetworkProfile) {
+				p.AdditionalFactories = []*AdditionalFactory{{Address: "0xc0aee478e3658e2610c5f7a4a2e1777ce9e4f2ac"}}
+			},
+			expectedError: "additional factory 0xc0aee478e3658e2610c5f7a4a2e1777ce9e4f2ac is the network factory",
+		},
+		{
+			name:          "unknown pricing mode",
+			update:        func(p *NetworkProfile) { p.PricingMode = "cheapest" },
+			expectedError: `unknown pricing mode "cheapest"`,
+		},
+		{
+			name:          "unknown estimator",
+			update:        func(p *NetworkProfile) { p.EthPriceEstimator = "mean" },
+			expectedError: `unknown eth price estimator "mean"`,
+		},
+		{
+			name: "source is not a reference pair",
+			update: func(p *NetworkProfile) {
+				p.EthPriceSources = [][]string{{"0x397ff1542f962076d0bfe58ea045ffa2d347aca0"}}
+			},
+			expectedError: "eth price source pair 0x397ff1542f962076d0bfe58ea045ffa2d347aca0 is not a reference pair",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			profile := testNetworkProfile()
+			test.update(profile)
+
+			err := profile.normalize()
+			if test.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "0xc0aee478e3658e2610c5f7a4a2e1777ce9e4f2ac", profile.FactoryAddress)
+			assert.Equal(t, "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", profile.NativeAddress)
+			assert.Equal(t, "0xc3d03e4f041fd4cd388c549ee2a29a9e5075882f", profile.EthPriceReferences[0].Pair)
+			assert.Equal(t, "0x6b175474e89094c44da98b954eedeac495271d0f", profile.EthPriceReferences[0].Token)
+			assert.Equal(t, [][]string{{"0xc3d03e4f041fd4cd388c549ee2a29a9e5075882f"}}, profile.EthPriceSources)
+			assert.Equal(t, []string{"0xc2edad668740f1aa35e4d8f227fb8e17dca888cd"}, profile.SushiNonCirculating)
+			assert.Equal(t, PricingModeFirstMatch, profile.PricingMode)
+			assert.Equal(t, EthPriceEstimatorWeightedAverage, profile.EthPriceEstimator)
+		})
+	}
+}
+
+func TestMainnetProfile_normalize(t *testing.T) {
+	require.NoError(t, MainnetProfile.normalize())
+}
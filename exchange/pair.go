@@ -1,35 +1,49 @@
 package exchange
 
 import (
+	"encoding/hex"
 	"fmt"
-	"github.com/streamingfast/sparkle/subgraph"
-	"go.uber.org/zap"
 	"math/big"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/streamingfast/eth-go"
 	"github.com/streamingfast/sparkle/entity"
+	"github.com/streamingfast/sparkle/subgraph"
+	"go.uber.org/zap"
 )
 
-func (s *Subgraph) getPair(pairAddress, token0Address, token1Address eth.Address) (*Pair, error) {
+// maxTokenDecimals is the largest `decimals()` value accepted, matching the `uint8` type of
+// the ERC20 specification
+var maxTokenDecimals = big.NewInt(255)
+
+func (s *Subgraph) getPair(pairAddress eth.Address) (*Pair, error) {
 	pair := NewPair(pairAddress.Pretty())
 	if err := s.Load(pair); err != nil {
 		return nil, err
 	}
 
-	if pair.Exists() {
-		return pair, nil
+	return pair, nil
+}
+
+// createPair returns the pair at `pairAddress` created by the factory at `factoryAddress`,
+// initializing it and its tokens when it does not exist yet.
+func (s *Subgraph) createPair(factoryAddress string, pairAddress, token0Address, token1Address eth.Address) (*Pair, error) {
+	pair, err := s.getPair(pairAddress)
+	if err != nil {
+		return nil, err
 	}
 
-	if token0Address == nil && token1Address == nil {
+	if pair.Exists() {
 		return pair, nil
 	}
 
-	token0, err := s.getToken(token0Address)
+	token0, err := s.getFactoryToken(factoryAddress, token0Address)
 	if err != nil {
 		return nil, err
 	}
 
-	token1, err := s.getToken(token1Address)
+	token1, err := s.getFactoryToken(factoryAddress, token1Address)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +66,7 @@ func (s *Subgraph) getPair(pairAddress, token0Address, token1Address eth.Address
 
 	pair.Token0 = token0.ID
 	pair.Token1 = token1.ID
-	pair.Factory = FactoryAddress
+	pair.Factory = factoryAddress
 	pair.Block = entity.NewIntFromLiteralUnsigned(s.Block().Number())
 	pair.Timestamp = entity.NewIntFromLiteral(s.Block().Timestamp().Unix())
 	pair.Name = fmt.Sprintf("%s-%s", token0.Symbol, token1.Symbol)
@@ -61,6 +75,13 @@ func (s *Subgraph) getPair(pairAddress, token0Address, token1Address eth.Address
 }
 
 func (s *Subgraph) getToken(tokenAddress eth.Address) (*Token, error) {
+	return s.getFactoryToken(network.FactoryAddress, tokenAddress)
+}
+
+// getFactoryToken returns the token at `tokenAddress`, creating it when it does not exist yet.
+// Tokens are shared by every factory, a new token being counted on the factory at
+// `factoryAddress`.
+func (s *Subgraph) getFactoryToken(factoryAddress string, tokenAddress eth.Address) (*Token, error) {
 	if tokenAddress == nil {
 		return nil, nil
 	}
@@ -75,7 +96,7 @@ func (s *Subgraph) getToken(tokenAddress eth.Address) (*Token, error) {
 		return token, nil
 	}
 
-	factory, err := s.getFactory()
+	factory, err := s.getFactory(factoryAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -110,33 +131,32 @@ func (s *Subgraph) getToken(tokenAddress eth.Address) (*Token, error) {
 		return nil, fmt.Errorf("rpc call error: %w", err)
 	}
 
+	token.DecimalsValid = entity.NewBool(true)
 	decimalsResponse := resps[0]
 	if decimalsResponse.CallError == nil && decimalsResponse.DecodingError == nil {
-		token.Decimals = IL(decimalsResponse.Decoded[0].(*big.Int).Int64())
-	}
-
-	nameResponse := resps[1]
-	if nameResponse.CallError == nil && nameResponse.DecodingError == nil {
-		token.Name = nameResponse.Decoded[0].(string)
-	} else {
-		token.Name = "unknown"
+		decimals := decimalsResponse.Decoded[0].(*big.Int)
+		if decimals.Cmp(maxTokenDecimals) <= 0 {
+			token.Decimals = I(decimals)
+		} else {
+			// unit conversions would be meaningless (or overflow), amounts are kept without decimals
+			// but the token is flagged and never priced
+			s.Log.Warn("token decimals out of range, ignoring", zap.String("token", token.ID), zap.Stringer("decimals", decimals))
+			token.DecimalsValid = entity.NewBool(false)
+		}
 	}
 
-	symbolResponse := resps[2]
-	if symbolResponse.CallError == nil && symbolResponse.DecodingError == nil {
-		token.Symbol = symbolResponse.Decoded[0].(string)
-	} else {
-		token.Symbol = "unknown"
-	}
+	token.Name = decodeTokenString(resps[1])
+	token.Symbol = decodeTokenString(resps[2])
 
 	totalSupplyResponse := resps[3]
 	if totalSupplyResponse.CallError == nil && totalSupplyResponse.DecodingError == nil {
-		token.TotalSupply = IL(totalSupplyResponse.Decoded[0].(*big.Int).Int64())
+		token.TotalSupply = I(totalSupplyResponse.Decoded[0].(*big.Int))
 	}
 
 	token.Factory = factory.ID
 	token.DerivedETH = FL(0)
 	token.WhitelistPairs = []string{}
+	token.Sanitize()
 
 	if err := s.Save(token); err != nil {
 		return nil, fmt.Errorf("saving token: %w", err)
@@ -145,6 +165,146 @@ func (s *Subgraph) getToken(tokenAddress eth.Address) (*Token, error) {
 	return token, nil
 }
 
+// decodeTokenString decodes the response of a `name()` or `symbol()` call, falling back to a
+// `bytes32` return value for non-standard tokens (MKR, SAI). Both signatures share the same
+// selector, so the raw response of the `string` call is decoded again instead of issuing
+// another RPC call.
+func decodeTokenString(resp *subgraph.RPCResponse) string {
+	if resp.CallError != nil {
+		return "unknown"
+	}
+
+	if resp.DecodingError == nil {
+		return resp.Decoded[0].(string)
+	}
+
+	data, err := hex.DecodeString(strings.TrimPrefix(resp.Raw, "0x"))
+	if err != nil || len(data) != 32 {
+		return "unknown"
+	}
+
+	value := strings.TrimRight(string(data), "\u0000")
+	if value == "" || !utf8.ValidString(value) {
+		return "unknown"
+	}
+
+	return value
+}
+
+// fetchTokenTotalSupplies reads the current total supply of each of `tokens` from the contracts
+// in a single batch, the supply of a token is nil when its call fails or cannot be decoded.
+func (s *Subgraph) fetchTokenTotalSupplies(tokens []*Token) ([]*big.Int, error) {
+	calls := make([]*subgraph.RPCCall, len(tokens))
+	for i, token := range tokens {
+		calls[i] = &subgraph.RPCCall{
+			ToAddr:          token.ID,
+			MethodSignature: "totalSupply() (uint256)",
+		}
+	}
+
+	resps, err := s.RPC(calls)
+	if err != nil {
+		return nil, fmt.Errorf("rpc call error: %w", err)
+	}
+
+	totalSupplies := make([]*big.Int, len(tokens))
+	for i, totalSupplyResponse := range resps {
+		if totalSupplyResponse.CallError != nil || totalSupplyResponse.DecodingError != nil {
+			continue
+		}
+		totalSupplies[i] = totalSupplyResponse.Decoded[0].(*big.Int)
+	}
+
+	return totalSupplies, nil
+}
+
+// pairReserves holds the raw reserves and timestamp of the last sync of every pair seen in the
+// run, what the contract accumulates prices from. Pairs missing from it have their accumulators
+// read from the contract once.
+var pairReserves map[string]*pairReservesState
+
+type pairReservesState struct {
+	reserve0, reserve1 *big.Int
+	blockTimestamp     uint32
+}
+
+var (
+	// q112 is the UQ112x112 fixed point scale of the cumulative prices
+	q112 = new(big.Int).Lsh(big.NewInt(1), 112)
+	// uint256Modulus wraps the cumulative prices, the contract letting them overflow
+	uint256Modulus = new(big.Int).Lsh(big.NewInt(1), 256)
+)
+
+// updatePairPriceAccumulators updates the cumulative prices and the timestamp of their last
+// update of `pair` on a sync to `reserve0` and `reserve1`, accumulating the previous reserves
+// over the elapsed time like the contract does. The first sync of a pair in the run reads the
+// accumulators from the contract instead. They only move on the first update of a block, so
+// reading them at the end of the block matches the value right after any of its syncs.
+func (s *Subgraph) updatePairPriceAccumulators(pair *Pair, reserve0, reserve1 *big.Int) error {
+	// the contract stores the timestamp modulo 2**32
+	blockTimestamp := uint32(s.Block().Timestamp().Unix())
+
+	previous, found := pairReserves[pair.ID]
+	if found {
+		price0, price1 := accumulatePrices(pair.Price0CumulativeLast.Int(), pair.Price1CumulativeLast.Int(), previous.reserve0, previous.reserve1, blockTimestamp-previous.blockTimestamp)
+		pair.Price0CumulativeLast = I(price0)
+		pair.Price1CumulativeLast = I(price1)
+	} else {
+		resps, err := s.RPC([]*subgraph.RPCCall{
+			{
+				ToAddr:          pair.ID,
+				MethodSignature: "price0CumulativeLast() (uint256)",
+			},
+			{
+				ToAddr:          pair.ID,
+				MethodSignature: "price1CumulativeLast() (uint256)",
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("rpc call error: %w", err)
+		}
+
+		for _, resp := range resps {
+			if resp.CallError != nil || resp.DecodingError != nil {
+				s.Log.Warn("unable to read pair price accumulators", zap.String("pair", pair.ID))
+				return nil
+			}
+		}
+
+		pair.Price0CumulativeLast = I(resps[0].Decoded[0].(*big.Int))
+		pair.Price1CumulativeLast = I(resps[1].Decoded[0].(*big.Int))
+	}
+
+	pair.BlockTimestampLast = int64(blockTimestamp)
+	pairReserves[pair.ID] = &pairReservesState{
+		reserve0:       new(big.Int).Set(reserve0),
+		reserve1:       new(big.Int).Set(reserve1),
+		blockTimestamp: blockTimestamp,
+	}
+
+	return nil
+}
+
+// accumulatePrices adds the UQ112x112 prices of `reserve0` and `reserve1` held for `elapsed`
+// seconds to the cumulative prices, nothing being accumulated within a block or while a reserve
+// is empty.
+func accumulatePrices(price0Cumulative, price1Cumulative, reserve0, reserve1 *big.Int, elapsed uint32) (*big.Int, *big.Int) {
+	price0 := new(big.Int).Set(price0Cumulative)
+	price1 := new(big.Int).Set(price1Cumulative)
+
+	if elapsed == 0 || reserve0.Sign() == 0 || reserve1.Sign() == 0 {
+		return price0, price1
+	}
+
+	timeElapsed := new(big.Int).SetUint64(uint64(elapsed))
+
+	// integer divisions, like the contract's uqdiv
+	price0.Add(price0, new(big.Int).Mul(new(big.Int).Quo(new(big.Int).Mul(reserve1, q112), reserve0), timeElapsed))
+	price1.Add(price1, new(big.Int).Mul(new(big.Int).Quo(new(big.Int).Mul(reserve0, q112), reserve1), timeElapsed))
+
+	return price0.Mod(price0, uint256Modulus), price1.Mod(price1, uint256Modulus)
+}
+
 func (s *Subgraph) getTrackedVolumeUSD(tokenAmount0 *big.Float, token0 *Token, tokenAmount1 *big.Float, token1 *Token, pair *Pair) (*big.Float, error) {
 	bundle, err := s.getBundle()
 	if err != nil {
@@ -0,0 +1,72 @@
+package exchange
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/streamingfast/sparkle/subgraph"
+	"github.com/stretchr/testify/assert"
+)
+
+// testBytes32 returns `value` right padded to 32 bytes, hex encoded.
+func testBytes32(value string) string {
+	data := make([]byte, 32)
+	copy(data, value)
+
+	return "0x" + hex.EncodeToString(data)
+}
+
+func TestDecodeTokenString(t *testing.T) {
+	decodingError := errors.New("decoding string")
+
+	tests := []struct {
+		name     string
+		resp     *subgraph.RPCResponse
+		expected string
+	}{
+		{"string", &subgraph.RPCResponse{Decoded: []interface{}{"Dai Stablecoin"}}, "Dai Stablecoin"},
+		{"call error", &subgraph.RPCResponse{CallError: errors.New("reverted")}, "unknown"},
+		{"bytes32", &subgraph.RPCResponse{DecodingError: decodingError, Raw: testBytes32("MKR")}, "MKR"},
+		{"bytes32 without prefix", &subgraph.RPCResponse{DecodingError: decodingError, Raw: testBytes32("SAI")[2:]}, "SAI"},
+		{"empty bytes32", &subgraph.RPCResponse{DecodingError: decodingError, Raw: testBytes32("")}, "unknown"},
+		{"invalid utf8", &subgraph.RPCResponse{DecodingError: decodingError, Raw: testBytes32("\xff\xfe")}, "unknown"},
+		{"not 32 bytes", &subgraph.RPCResponse{DecodingError: decodingError, Raw: "0x4d4b52"}, "unknown"},
+		{"invalid hex", &subgraph.RPCResponse{DecodingError: decodingError, Raw: "0xzz"}, "unknown"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, decodeTokenString(test.resp))
+		})
+	}
+}
+
+func TestAccumulatePrices(t *testing.T) {
+	q := func(multiple int64) *big.Int { return new(big.Int).Mul(q112, big.NewInt(multiple)) }
+	maxUint256 := new(big.Int).Sub(uint256Modulus, big.NewInt(1))
+
+	tests := []struct {
+		name               string
+		price0, price1     *big.Int
+		reserve0, reserve1 int64
+		elapsed            uint32
+		expected0          *big.Int
+		expected1          *big.Int
+	}{
+		{"accumulated", q(1), q(1), 1, 2, 10, q(21), new(big.Int).Add(q(1), new(big.Int).Mul(new(big.Int).Rsh(q112, 1), big.NewInt(10)))},
+		{"same block", q(1), q(1), 1, 2, 0, q(1), q(1)},
+		{"empty reserve", q(1), q(1), 0, 2, 10, q(1), q(1)},
+		{"overflow", maxUint256, big.NewInt(0), 1, 1, 1, new(big.Int).Sub(q112, big.NewInt(1)), q112},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			price0, price1 := accumulatePrices(test.price0, test.price1, big.NewInt(test.reserve0), big.NewInt(test.reserve1), test.elapsed)
+
+			assert.Equal(t, 0, test.expected0.Cmp(price0), "expected %s, got %s", test.expected0, price0)
+			assert.Equal(t, 0, test.expected1.Cmp(price1), "expected %s, got %s", test.expected1, price1)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package exchange
 
 import (
 	"math/big"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/streamingfast/eth-go"
@@ -12,282 +14,544 @@ import (
 var (
 	MinimumLiquidityThresholdEth = big.NewFloat(10)
 	MinimumUSDThresholdNewPairs  = big.NewFloat(3000.0)
+
+	// MaximumPricingHops bounds the length of the routes pricing tokens without a liquid
+	// whitelisted pair
+	MaximumPricingHops = 3
+
+	// 0.3% is taken on the swap input amounts, of which 0.25% goes to liquidity
+	// providers and 0.05% to the protocol (xSUSHI)
+	SwapFeeRate     = big.NewFloat(0.003)
+	LPFeeRate       = big.NewFloat(0.0025)
+	ProtocolFeeRate = big.NewFloat(0.0005)
+
+	// EthPriceMaxDeviation is the largest relative change of the ETH price accepted between two
+	// updates, larger jumps are recorded as a `PriceAnomaly` and the previous price is kept
+	EthPriceMaxDeviation = big.NewFloat(0.5)
+	// EthPriceGuardConfirmationBlocks is the number of blocks a deviating price must hold, within
+	// `EthPriceMaxDeviation` of itself, before being accepted, so a genuine move is not rejected
+	// forever while a manipulated one has to be sustained that long
+	EthPriceGuardConfirmationBlocks = uint64(100)
 )
 
 const (
-	NativeAddress = "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"
+	priceAnomalyZero      = "zero"
+	priceAnomalyDeviation = "deviation"
+)
 
-	DaiWethPair  = "0xc3d03e4f041fd4cd388c549ee2a29a9e5075882f"
-	UsdcWethPair = "0x397ff1542f962076d0bfe58ea045ffa2d347aca0"
-	UsdtWethPair = "0x06da0fd433c1a5d7a4faa01111c044910a184553"
+// GetEthPriceInUSD returns the price of the native token in USD computed from the network
+// reference pairs, along with the quote of every existing reference pair. The first source,
+// in `EthPriceSources` order, whose reference pairs all hold more than their liquidity
+// threshold is used, the pair prices being combined by the network `EthPriceEstimator`.
+func (s *Subgraph) GetEthPriceInUSD() (*big.Float, []*EthPriceQuote, error) {
+	var quotes []*EthPriceQuote
+	liquidQuotes := map[string]*EthPriceQuote{}
+	var liquidPairs []string
+	for _, ref := range network.EthPriceReferences {
+		quote, err := s.getEthPriceQuote(ref)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	DAI  = "0x6b175474e89094c44da98b954eedeac495271d0f"
-	USDC = "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
-	USDT = "0xdac17f958d2ee523a2206206994597c13d831ec7"
-)
+		if quote == nil {
+			continue
+		}
 
-func (s *Subgraph) GetEthPriceInUSD() (*big.Float, error) {
-	daiPair, err := s.getPair(eth.MustNewAddress(DaiWethPair), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	usdcPair, err := s.getPair(eth.MustNewAddress(UsdcWethPair), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	usdtPair, err := s.getPair(eth.MustNewAddress(UsdtWethPair), nil, nil)
-	if err != nil {
-		return nil, err
+		quotes = append(quotes, quote)
+		if quote.Liquid {
+			liquidQuotes[ref.Pair] = quote
+			liquidPairs = append(liquidPairs, ref.Pair)
+		}
 	}
 
-	isDaiPairLiquidEnough := bool(daiPair.ReserveETH.Float().Cmp(MinimumLiquidityThresholdEth) > 0)
-	isUsdcPairLiquidEnough := bool(usdcPair.ReserveETH.Float().Cmp(MinimumLiquidityThresholdEth) > 0)
-	isUsdtPairLiquidEnough := bool(usdtPair.ReserveETH.Float().Cmp(MinimumLiquidityThresholdEth) > 0)
+	sources := network.EthPriceSources
+	if len(sources) == 0 {
+		sources = [][]string{liquidPairs}
+	}
 
-	if daiPair.Exists() && isDaiPairLiquidEnough && usdcPair.Exists() && isUsdcPairLiquidEnough && usdtPair.Exists() && isUsdtPairLiquidEnough {
-		isDaiFirst := daiPair.Token0 == DAI
-		isUsdcFirst := usdcPair.Token0 == USDC
-		isUsdtFirst := usdtPair.Token0 == USDT
+	for _, source := range sources {
+		sourceQuotes := make([]*EthPriceQuote, 0, len(source))
+		for _, pairAddress := range source {
+			if quote, found := liquidQuotes[pairAddress]; found {
+				sourceQuotes = append(sourceQuotes, quote)
+			}
+		}
 
-		var daiPairEth *big.Float
-		if isDaiFirst {
-			daiPairEth = daiPair.Reserve1.Float()
-		} else {
-			daiPairEth = daiPair.Reserve0.Float()
+		if len(sourceQuotes) == 0 || len(sourceQuotes) != len(source) {
+			continue
 		}
 
-		var usdcPairEth *big.Float
-		if isUsdcFirst {
-			usdcPairEth = usdcPair.Reserve1.Float()
-		} else {
-			usdcPairEth = usdcPair.Reserve0.Float()
+		var price *big.Float
+		switch network.EthPriceEstimator {
+		case EthPriceEstimatorWeightedMedian:
+			price = weightedMedianEthPrice(sourceQuotes)
+		default:
+			price = weightedAverageEthPrice(sourceQuotes)
 		}
 
-		var usdtPairEth *big.Float
-		if isUsdtFirst {
-			usdtPairEth = usdtPair.Reserve1.Float()
-		} else {
-			usdtPairEth = usdtPair.Reserve0.Float()
+		for _, quote := range sourceQuotes {
+			quote.Used = true
 		}
 
-		totalLiquidityEth := bf().Add(daiPairEth, bf().Add(usdcPairEth, usdtPairEth).SetPrec(100)).SetPrec(100)
+		s.Log.Debug("eth price calculated from reference pairs", zap.Strings("pairs", source), zap.Stringer("price", price))
+		return price, quotes, nil
+	}
+
+	s.Log.Debug("eth price could not be calculated")
+	return big.NewFloat(0), quotes, nil
+}
 
-		var daiWeight *big.Float
-		if !isDaiFirst {
-			daiWeight = bf().Quo(daiPair.Reserve0.Float(), totalLiquidityEth).SetPrec(100)
-		} else {
-			daiWeight = bf().Quo(daiPair.Reserve1.Float(), totalLiquidityEth).SetPrec(100)
+// updateBundleEthPrice moves the bundle ETH price to `price`, computed at `blockNum`, unless the
+// guard rejects it, returning why in that case. The last accepted price is the reference
+// whatever its age: a price deviating from it becomes pending instead, and is only accepted once
+// every price computed over `EthPriceGuardConfirmationBlocks` blocks stayed close to it. Nothing
+// is guarded until a first price is known.
+func updateBundleEthPrice(bundle *Bundle, price *big.Float, blockNum uint64) string {
+	lastPrice := bundle.EthPrice.Float()
+	if lastPrice.Sign() != 0 {
+		if price.Sign() == 0 {
+			return priceAnomalyZero
 		}
 
-		var usdcWeight *big.Float
-		if !isUsdcFirst {
-			usdcWeight = bf().Quo(usdcPair.Reserve0.Float(), totalLiquidityEth).SetPrec(100)
-		} else {
-			usdcWeight = bf().Quo(usdcPair.Reserve1.Float(), totalLiquidityEth).SetPrec(100)
-		}
+		if ethPriceDeviates(price, lastPrice) {
+			pendingPrice := bundle.PendingEthPrice.Float()
+			if pendingPrice.Sign() == 0 || ethPriceDeviates(price, pendingPrice) {
+				bundle.PendingEthPrice = F(price)
+				bundle.PendingEthPriceBlock = int64(blockNum)
+				return priceAnomalyDeviation
+			}
 
-		var usdtWeight *big.Float
-		if !isUsdtFirst {
-			usdtWeight = bf().Quo(usdtPair.Reserve0.Float(), totalLiquidityEth).SetPrec(100)
-		} else {
-			usdtWeight = bf().Quo(usdtPair.Reserve1.Float(), totalLiquidityEth).SetPrec(100)
+			if blockNum < uint64(bundle.PendingEthPriceBlock)+EthPriceGuardConfirmationBlocks {
+				return priceAnomalyDeviation
+			}
 		}
+	}
 
-		var daiPrice *big.Float
-		if isDaiFirst {
-			daiPrice = daiPair.Token0Price.Float()
-		} else {
-			daiPrice = daiPair.Token1Price.Float()
+	bundle.EthPrice = F(price)
+	bundle.EthPriceBlock = int64(blockNum)
+	bundle.PendingEthPrice = FL(0)
+	bundle.PendingEthPriceBlock = 0
+
+	return ""
+}
+
+// ethPriceDeviates returns whether `price` moved from `reference` by more than
+// `EthPriceMaxDeviation`.
+func ethPriceDeviates(price, reference *big.Float) bool {
+	deviation := bf().Quo(bf().Sub(price, reference), reference)
+	return deviation.Abs(deviation).Cmp(EthPriceMaxDeviation) > 0
+}
+
+// savePriceAnomaly records the first ETH price rejected in the block, the guard rejecting the
+// price computed on every sync for as long as it deviates.
+func (s *Subgraph) savePriceAnomaly(ev *PairSyncEvent, pair *Pair, oldPrice, newPrice *big.Float, reason string) error {
+	anomaly := NewPriceAnomaly(strconv.FormatUint(ev.Block.Number, 10))
+	if err := s.Load(anomaly); err != nil {
+		return err
+	}
+
+	if anomaly.Exists() {
+		return nil
+	}
+
+	anomaly.Block = int64(ev.Block.Number)
+	anomaly.Timestamp = IL(s.Block().Timestamp().Unix())
+	anomaly.Transaction = ev.Transaction.Hash.Pretty()
+	anomaly.Pair = pair.ID
+	anomaly.OldPrice = F(oldPrice)
+	anomaly.NewPrice = F(newPrice)
+	anomaly.Reason = reason
+
+	s.Log.Info("eth price anomaly, keeping previous price",
+		zap.Uint64("block", ev.Block.Number),
+		zap.String("pair", pair.ID),
+		zap.String("reason", reason),
+		zap.String("old_price", oldPrice.Text('g', -1)),
+		zap.String("new_price", newPrice.Text('g', -1)),
+	)
+
+	return s.Save(anomaly)
+}
+
+// EthPriceQuote is the native token price in USD given by a single reference pair, along with
+// the native token reserve of the pair used as its weight.
+type EthPriceQuote struct {
+	Pair         string
+	Price        *big.Float
+	LiquidityETH *big.Float
+
+	// Liquid is set when the pair holds more than its liquidity threshold, Used when the quote
+	// contributed to the computed price
+	Liquid bool
+	Used   bool
+}
+
+// getEthPriceQuote returns the quote of the reference pair, nil when the pair does not exist.
+func (s *Subgraph) getEthPriceQuote(ref *ReferencePair) (*EthPriceQuote, error) {
+	pair, err := s.getPair(eth.MustNewAddress(ref.Pair))
+	if err != nil {
+		return nil, err
+	}
+
+	if !pair.Exists() {
+		return nil, nil
+	}
+
+	quote := &EthPriceQuote{
+		Pair:   ref.Pair,
+		Liquid: pair.ReserveETH.Float().Cmp(ref.minimumLiquidityEth()) > 0,
+	}
+
+	if pair.Token0 == ref.Token {
+		quote.Price, quote.LiquidityETH = pair.Token0Price.Float(), pair.Reserve1.Float()
+	} else {
+		quote.Price, quote.LiquidityETH = pair.Token1Price.Float(), pair.Reserve0.Float()
+	}
+
+	return quote, nil
+}
+
+// weightedAverageEthPrice averages the quotes weighted by their liquidity. Sums are folded from
+// the last quote so results match, to the bit, the historical DAI/USDC/USDT computation.
+func weightedAverageEthPrice(quotes []*EthPriceQuote) *big.Float {
+	last := len(quotes) - 1
+
+	totalLiquidityEth := quotes[last].LiquidityETH
+	for i := last - 1; i >= 0; i-- {
+		totalLiquidityEth = bf().Add(quotes[i].LiquidityETH, totalLiquidityEth).SetPrec(100)
+	}
+
+	var weightedPrice *big.Float
+	for i := last; i >= 0; i-- {
+		weight := bf().Quo(quotes[i].LiquidityETH, totalLiquidityEth).SetPrec(100)
+		quotePrice := bf().Mul(quotes[i].Price, weight).SetPrec(100)
+		if weightedPrice == nil {
+			weightedPrice = quotePrice
+			continue
 		}
+		weightedPrice = bf().Add(quotePrice, weightedPrice).SetPrec(100)
+	}
 
-		var usdcPrice *big.Float
-		if isUsdcFirst {
-			usdcPrice = usdcPair.Token0Price.Float()
-		} else {
-			usdcPrice = usdcPair.Token1Price.Float()
+	return weightedPrice
+}
+
+// weightedMedianEthPrice returns the price of the quote at which the cumulated liquidity, with
+// quotes sorted by price, reaches half of the total liquidity.
+func weightedMedianEthPrice(quotes []*EthPriceQuote) *big.Float {
+	sorted := make([]*EthPriceQuote, len(quotes))
+	copy(sorted, quotes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Price.Cmp(sorted[j].Price) < 0
+	})
+
+	totalLiquidityEth := bf()
+	for _, quote := range sorted {
+		totalLiquidityEth.Add(totalLiquidityEth, quote.LiquidityETH)
+	}
+	halfLiquidityEth := bf().Quo(totalLiquidityEth, big.NewFloat(2))
+
+	cumulatedLiquidityEth := bf()
+	for _, quote := range sorted {
+		cumulatedLiquidityEth.Add(cumulatedLiquidityEth, quote.LiquidityETH)
+		if cumulatedLiquidityEth.Cmp(halfLiquidityEth) >= 0 {
+			return bf().Set(quote.Price).SetPrec(100)
 		}
+	}
 
-		var usdtPrice *big.Float
-		if isUsdtFirst {
-			usdtPrice = usdtPair.Token0Price.Float()
-		} else {
-			usdtPrice = usdtPair.Token1Price.Float()
+	return bf().Set(sorted[len(sorted)-1].Price).SetPrec(100)
+}
+
+// EthPriceRoute is a token price in ETH along with the pairs it was derived through.
+type EthPriceRoute struct {
+	Price *big.Float
+	// liquidity of the shallowest pair of the route, in ETH, nil when the route has no hop
+	Liquidity *big.Float
+	Pairs     []string
+	Hops      int
+}
+
+// FindEthPerToken returns the price of `token` in ETH. Whitelisted pairs holding enough
+// liquidity are used first, according to the network pricing mode, tokens without one are
+// priced through the pair graph, see `findEthPriceRoute`.
+func (s *Subgraph) FindEthPerToken(token *Token) (*EthPriceRoute, error) {
+	tokenAddress := eth.MustNewAddress(token.GetID()).Pretty()
+	if tokenAddress == network.NativeAddress {
+		return &EthPriceRoute{Price: big.NewFloat(1), Pairs: []string{}}, nil
+	}
+
+	if !token.DecimalsValid {
+		// amounts of the token are meaningless, keep it out of the ETH/USD aggregates
+		return &EthPriceRoute{Price: big.NewFloat(0), Pairs: []string{}}, nil
+	}
+
+	var route *EthPriceRoute
+	var err error
+	switch network.PricingMode {
+	case PricingModeLiquidityWeighted:
+		route, err = s.findLiquidityWeightedWhitelistPrice(tokenAddress, token.WhitelistPairs)
+	default:
+		route, err = s.findFirstWhitelistPrice(tokenAddress, token.WhitelistPairs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if route != nil {
+		return route, nil
+	}
+
+	route, err = s.findEthPriceRoute(tokenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if route != nil {
+		s.Log.Debug("eth per token from pair graph route",
+			zap.String("token", tokenAddress),
+			zap.Strings("route", route.Pairs),
+			zap.String("liquidity_eth", route.Liquidity.Text('g', -1)),
+		)
+		return route, nil
+	}
+
+	s.Log.Debug("no whitelisted pairs nor pricing route")
+	return &EthPriceRoute{Price: big.NewFloat(0), Pairs: []string{}}, nil
+}
+
+// findFirstWhitelistPrice prices the token with the first whitelisted pair holding more than
+// `MinimumLiquidityThresholdEth`, nil is returned when there is none.
+func (s *Subgraph) findFirstWhitelistPrice(tokenAddress string, whitelistPairs []string) (*EthPriceRoute, error) {
+	for _, pairAddress := range whitelistPairs {
+		pair := NewPair(pairAddress)
+		if err := s.Load(pair); err != nil {
+			return nil, err
 		}
 
-		weightedDaiPrice := bf().Mul(daiPrice, daiWeight).SetPrec(100)
-		weightedUsdcPrice := bf().Mul(usdcPrice, usdcWeight).SetPrec(100)
-		weightedUsdtPrice := bf().Mul(usdtPrice, usdtWeight).SetPrec(100)
-		weightedPrice := bf().Add(weightedDaiPrice, bf().Add(weightedUsdcPrice, weightedUsdtPrice)).SetPrec(100)
+		s.Log.Debug("eth per token",
+			zap.Int("step", s.Step()), zap.Uint64("block", s.Block().Number()),
+			zap.String("pair_name", pair.Name),
+			zap.Bool("pair exists", pair.Exists()),
+			zap.String("pair_token0_price", pair.Token0Price.Float().Text('b', -1)),
+			zap.String("pair_token1_price", pair.Token1Price.Float().Text('b', -1)),
+		)
 
-		s.Log.Debug("eth price calculated from dai/usdc", zap.Stringer("price", weightedPrice))
-		return weightedPrice, nil
-	} else if daiPair.Exists() && isDaiPairLiquidEnough && usdcPair.Exists() && isUsdcPairLiquidEnough {
-		isDaiFirst := daiPair.Token0 == DAI
-		isUsdcFirst := usdcPair.Token0 == USDC
+		if pair.ReserveETH.Float().Cmp(MinimumLiquidityThresholdEth) <= 0 {
+			continue
+		}
 
-		var daiPairEth *big.Float
-		if isDaiFirst {
-			daiPairEth = daiPair.Reserve1.Float()
-		} else {
-			daiPairEth = daiPair.Reserve0.Float()
+		price, err := s.whitelistPairPrice(tokenAddress, pair)
+		if err != nil {
+			return nil, err
 		}
 
-		var usdcPairEth *big.Float
-		if isUsdcFirst {
-			usdcPairEth = usdcPair.Reserve1.Float()
-		} else {
-			usdcPairEth = usdcPair.Reserve0.Float()
+		if price != nil {
+			return &EthPriceRoute{Price: price, Liquidity: pair.ReserveETH.Float(), Pairs: []string{pairAddress}, Hops: 1}, nil
 		}
+	}
 
-		totalLiquidityEth := bf().Add(daiPairEth, usdcPairEth).SetPrec(100)
+	return nil, nil
+}
 
-		var daiWeight *big.Float
-		if !isDaiFirst {
-			daiWeight = bf().Quo(daiPair.Reserve0.Float(), totalLiquidityEth).SetPrec(100)
-		} else {
-			daiWeight = bf().Quo(daiPair.Reserve1.Float(), totalLiquidityEth).SetPrec(100)
+// findLiquidityWeightedWhitelistPrice prices the token with the average of the prices given by
+// every whitelisted pair holding more than `MinimumLiquidityThresholdEth`, weighted by the pair
+// reserves in ETH. nil is returned when no pair qualifies.
+func (s *Subgraph) findLiquidityWeightedWhitelistPrice(tokenAddress string, whitelistPairs []string) (*EthPriceRoute, error) {
+	weightedPrice := bf()
+	totalLiquidity := bf()
+	var pairs []string
+
+	for _, pairAddress := range whitelistPairs {
+		pair := NewPair(pairAddress)
+		if err := s.Load(pair); err != nil {
+			return nil, err
 		}
 
-		var usdcWeight *big.Float
-		if !isUsdcFirst {
-			usdcWeight = bf().Quo(usdcPair.Reserve0.Float(), totalLiquidityEth).SetPrec(100)
-		} else {
-			usdcWeight = bf().Quo(usdcPair.Reserve1.Float(), totalLiquidityEth).SetPrec(100)
+		if pair.ReserveETH.Float().Cmp(MinimumLiquidityThresholdEth) <= 0 {
+			continue
 		}
 
-		var daiPrice *big.Float
-		if isDaiFirst {
-			daiPrice = daiPair.Token0Price.Float()
-		} else {
-			daiPrice = daiPair.Token1Price.Float()
+		price, err := s.whitelistPairPrice(tokenAddress, pair)
+		if err != nil {
+			return nil, err
 		}
 
-		var usdcPrice *big.Float
-		if isUsdcFirst {
-			usdcPrice = usdcPair.Token0Price.Float()
-		} else {
-			usdcPrice = usdcPair.Token1Price.Float()
+		if price == nil {
+			continue
 		}
 
-		weightedDaiPrice := bf().Mul(daiPrice, daiWeight).SetPrec(100)
-		weightedUsdcPrice := bf().Mul(usdcPrice, usdcWeight).SetPrec(100)
-		weightedPrice := bf().Add(weightedDaiPrice, weightedUsdcPrice).SetPrec(100)
+		weightedPrice.Add(weightedPrice, bf().Mul(price, pair.ReserveETH.Float()))
+		totalLiquidity.Add(totalLiquidity, pair.ReserveETH.Float())
+		pairs = append(pairs, pairAddress)
+	}
 
-		s.Log.Debug("eth price calculated from dai/usdc", zap.Stringer("price", weightedPrice))
-		return weightedPrice, nil
-	} else if usdcPair.Exists() && isUsdcPairLiquidEnough {
-		isUsdcFirst := usdcPair.Token0 == USDC
+	if len(pairs) == 0 {
+		return nil, nil
+	}
 
-		var usdcPrice *big.Float
-		if isUsdcFirst {
-			usdcPrice = usdcPair.Token0Price.Float()
-		} else {
-			usdcPrice = usdcPair.Token1Price.Float()
-		}
+	price := bf().Quo(weightedPrice, totalLiquidity)
+	s.Log.Debug("eth per token weighted by liquidity",
+		zap.String("token", tokenAddress),
+		zap.Strings("pairs", pairs),
+		zap.String("price", price.Text('g', -1)),
+	)
 
-		s.Log.Debug("eth price calculated from usdc", zap.Stringer("price", usdcPrice))
-		return usdcPrice.SetPrec(100), nil
-	} else if usdtPair.Exists() && isUsdtPairLiquidEnough {
-		isUsdtFirst := usdtPair.Token0 == USDT
+	return &EthPriceRoute{Price: price, Liquidity: totalLiquidity, Pairs: pairs, Hops: 1}, nil
+}
 
-		var usdtPrice *big.Float
-		if isUsdtFirst {
-			usdtPrice = usdtPair.Token0Price.Float()
-		} else {
-			usdtPrice = usdtPair.Token1Price.Float()
+// whitelistPairPrice returns the price in ETH of `tokenAddress` given by `pair`, using the
+// derived price of the other token. nil is returned when the token is not part of the pair.
+func (s *Subgraph) whitelistPairPrice(tokenAddress string, pair *Pair) (*big.Float, error) {
+	if pair.Token0 == tokenAddress {
+		token1 := NewToken(pair.Token1)
+		if err := s.Load(token1); err != nil {
+			return nil, err
 		}
+		return bf().Mul(pair.Token1Price.Float(), token1.DerivedETH.Float()), nil
+	}
+	if pair.Token1 == tokenAddress {
+		token0 := NewToken(pair.Token0)
+		if err := s.Load(token0); err != nil {
+			return nil, err
+		}
+		return bf().Mul(pair.Token0Price.Float(), token0.DerivedETH.Float()), nil
+	}
 
-		s.Log.Debug("eth price calculated from usdt", zap.Stringer("price", usdtPrice))
-		return usdtPrice.SetPrec(100), nil
-	} else if daiPair.Exists() && isDaiPairLiquidEnough {
-		isDaiFirst := daiPair.Token0 == DAI
+	return nil, nil
+}
 
-		var daiPrice *big.Float
-		if isDaiFirst {
-			daiPrice = daiPair.Token0Price.Float()
-		} else {
-			daiPrice = daiPair.Token1Price.Float()
+// findEthPriceRoute prices `tokenAddress` by walking the pair graph to the native token, over
+// routes of at most `MaximumPricingHops` pairs never going twice through the same token. Every
+// pair of a route must hold more than `MinimumLiquidityThresholdEth`, valued with the price its
+// token closer to the native token gets from the rest of the route, and the route whose weakest
+// pair holds the most liquidity is used. nil is returned when no route qualifies.
+func (s *Subgraph) findEthPriceRoute(tokenAddress string) (*EthPriceRoute, error) {
+	var routes [][]*Pair
+	visited := map[string]bool{tokenAddress: true}
+	if err := s.collectEthPriceRoutes(tokenAddress, nil, visited, &routes); err != nil {
+		return nil, err
+	}
+
+	var best *EthPriceRoute
+	for _, pairs := range routes {
+		route := ethPriceRoute(tokenAddress, pairs)
+		if route == nil {
+			continue
 		}
 
-		s.Log.Debug("eth price calculated from dai", zap.Stringer("price", daiPrice))
-		return daiPrice.SetPrec(100), nil
+		if best == nil || route.Liquidity.Cmp(best.Liquidity) > 0 {
+			best = route
+		}
 	}
 
-	s.Log.Debug("eth price could not be calculated")
-	return big.NewFloat(0), nil
+	return best, nil
 }
 
-func (s *Subgraph) FindEthPerToken(token *Token) (*big.Float, error) {
-	tokenAddress := eth.MustNewAddress(token.GetID()).Pretty()
-	if tokenAddress == NativeAddress {
-		return big.NewFloat(1), nil
+// collectEthPriceRoutes appends to `routes` every route from `tokenAddress`, reached through
+// `path`, to the native token, the last hop only looking up direct pairs with the native token.
+func (s *Subgraph) collectEthPriceRoutes(tokenAddress string, path []*Pair, visited map[string]bool, routes *[][]*Pair) error {
+	pairAddresses := tokenPairs[tokenAddress]
+	if len(path) == MaximumPricingHops-1 {
+		pairAddresses = nativePairAddresses(tokenAddress)
 	}
 
-	for _, pairAddress := range token.WhitelistPairs {
+	for _, pairAddress := range pairAddresses {
 		pair := NewPair(pairAddress)
 		if err := s.Load(pair); err != nil {
-			return nil, err
+			return err
 		}
 
-		s.Log.Debug("eth per token",
-			zap.Int("step", s.Step()), zap.Uint64("block", s.Block().Number()),
-			zap.String("pair_name", pair.Name),
-			zap.Bool("pair exists", pair.Exists()),
-			zap.String("pair_token0_price", pair.Token0Price.Float().Text('b', -1)),
-			zap.String("pair_token1_price", pair.Token1Price.Float().Text('b', -1)),
-		)
+		if !pair.Exists() {
+			continue
+		}
 
-		if pair.Token0 == tokenAddress && pair.ReserveETH.Float().Cmp(MinimumLiquidityThresholdEth) > 0 {
-			token1 := NewToken(pair.Token1)
-			if err := s.Load(token1); err != nil {
-				return nil, err
-			}
-			return bf().Mul(pair.Token1Price.Float(), token1.DerivedETH.Float()), nil
+		otherAddress := pair.Token1
+		if pair.Token1 == tokenAddress {
+			otherAddress = pair.Token0
 		}
-		if pair.Token1 == tokenAddress && pair.ReserveETH.Float().Cmp(MinimumLiquidityThresholdEth) > 0 {
-			token0 := NewToken(pair.Token0)
-			if err := s.Load(token0); err != nil {
-				return nil, err
-			}
-			return bf().Mul(pair.Token0Price.Float(), token0.DerivedETH.Float()), nil
+
+		route := append(append([]*Pair{}, path...), pair)
+		if otherAddress == network.NativeAddress {
+			*routes = append(*routes, route)
+			continue
+		}
+
+		if visited[otherAddress] || len(route) == MaximumPricingHops {
+			continue
 		}
+
+		visited[otherAddress] = true
+		if err := s.collectEthPriceRoutes(otherAddress, route, visited, routes); err != nil {
+			return err
+		}
+		visited[otherAddress] = false
 	}
 
-	s.Log.Debug("no whitelisted pairs")
-	return big.NewFloat(0), nil
+	return nil
 }
 
-// whitelist is a slice because we need to respect the order when using it in certain location, so
-// we must not converted to a map[string]bool directly unless there is a strict ordering way to list them.
-var whitelist = []string{
-	"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
-	"0x2260fac5e5542a773aa44fbcfedf7c193bc2c599",
-	"0x6b175474e89094c44da98b954eedeac495271d0f",
-	"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
-	"0xdac17f958d2ee523a2206206994597c13d831ec7",
-	"0x0000000000085d4780b73119b644ae5ecd22b376",
-	"0x5d3a536e4d6dbd6114cc1ead35777bab948e3643",
-	"0x57ab1ec28d129707052df4df418d58a2d46d5f51",
-	"0x514910771af9ca656af840dff83e8264ecf986ca",
-	"0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e",
-	"0x8798249c2e607446efb7ad49ec89dd1865ff4272",
-	"0x1456688345527be1f37e9e627da0837d6f08c925",
-	"0x3449fc1cd036255ba1eb19d65ff4ba2b8903a69a",
-	"0x2ba592f78db6436527729929aaf6c908497cb200",
-	"0x3432b6a60d23ca0dfca7761b7ab56459d9c964d0",
-	"0xa1faa113cbe53436df28ff0aee54275c13b40975",
-	"0xdb0f18081b505a7de20b18ac41856bcb4ba86a1a",
-	"0x04fa0d235c4abf4bcf4787af4cf447de572ef828",
-	"0x3155ba85d5f96b2d030a4966af206230e46849cb",
-	"0x87d73e916d7057945c9bcd8cdd94e42a6f47f776",
-	"0xdfe66b14d37c77f4e9b180ceb433d1b164f0281d",
-	"0xad32a8e6220741182940c5abf610bde99e737b2d",
-	"0xafcE9B78D409bF74980CACF610AFB851BF02F257",
-	"0x6b3595068778dd592e39a122f4f5a5cf09c90fe2",
+// nativePairAddresses returns the pairs of `tokenAddress` with the native token, one per factory
+// listing it.
+func nativePairAddresses(tokenAddress string) []string {
+	factories := []string{network.FactoryAddress}
+	for _, factory := range network.AdditionalFactories {
+		factories = append(factories, factory.Address)
+	}
+
+	var pairAddresses []string
+	for _, factory := range factories {
+		if pairAddress, found := tokensToPair[generateTokensKey(factory, tokenAddress, network.NativeAddress)]; found {
+			pairAddresses = append(pairAddresses, pairAddress)
+		}
+	}
+
+	return pairAddresses
 }
 
-var blacklist = []string{
-	"0x9ea3b5b4ec044b70375236a281986106457b20ef",
+// ethPriceRoute prices `tokenAddress` through `pairs`, the last one holding the native token.
+// Prices are derived from the native token back to `tokenAddress`, and nil is returned as soon as
+// a pair holds `MinimumLiquidityThresholdEth` or less.
+func ethPriceRoute(tokenAddress string, pairs []*Pair) *EthPriceRoute {
+	// tokens[i] and tokens[i+1] are the tokens of pairs[i]
+	tokens := []string{tokenAddress}
+	for _, pair := range pairs {
+		last := tokens[len(tokens)-1]
+		if pair.Token0 == last {
+			tokens = append(tokens, pair.Token1)
+		} else {
+			tokens = append(tokens, pair.Token0)
+		}
+	}
+
+	price := big.NewFloat(1)
+	var liquidity *big.Float
+	for i := len(pairs) - 1; i >= 0; i-- {
+		pair := pairs[i]
+
+		// `price` is the one of tokens[i+1], the rate is the amount of it received for one tokens[i]
+		reserve, rate := pair.Reserve1, pair.Token1Price
+		if pair.Token0 == tokens[i+1] {
+			reserve, rate = pair.Reserve0, pair.Token0Price
+		}
+
+		// both sides of a pair hold the same value, the priced side is known so count it twice
+		pairLiquidity := bf().Mul(bf().Mul(reserve.Float(), price), big.NewFloat(2))
+		if pairLiquidity.Cmp(MinimumLiquidityThresholdEth) <= 0 {
+			return nil
+		}
+
+		if liquidity == nil || pairLiquidity.Cmp(liquidity) < 0 {
+			liquidity = pairLiquidity
+		}
+
+		price = bf().Mul(rate.Float(), price)
+	}
+
+	pairAddresses := make([]string, len(pairs))
+	for i, pair := range pairs {
+		pairAddresses[i] = pair.ID
+	}
+
+	return &EthPriceRoute{Price: price, Liquidity: liquidity, Pairs: pairAddresses, Hops: len(pairs)}
 }
 
 var whitelistCacheMap = map[string]bool{}
@@ -300,7 +564,7 @@ func isWhitelistedAddress(address string) bool {
 		return true
 	}
 
-	for _, addr := range whitelist {
+	for _, addr := range network.Whitelist {
 		if strings.ToLower(addr) != address {
 			continue
 		}
@@ -319,7 +583,7 @@ func isBlacklistedAddress(address string) bool {
 		return true
 	}
 
-	for _, addr := range blacklist {
+	for _, addr := range network.Blacklist {
 		if strings.ToLower(addr) != address {
 			continue
 		}
@@ -0,0 +1,355 @@
+package exchange
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/sparkle/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTokenA = "0x00000000000000000000000000000000000000a0"
+	testTokenB = "0x00000000000000000000000000000000000000b0"
+	testTokenC = "0x00000000000000000000000000000000000000c0"
+	testTokenD = "0x00000000000000000000000000000000000000d0"
+
+	testPairAWeth = "0x000000000000000000000000000000000000a001"
+	testPairAB    = "0x000000000000000000000000000000000000a0b0"
+	testPairAC    = "0x000000000000000000000000000000000000a0c0"
+	testPairBC    = "0x000000000000000000000000000000000000b0c0"
+	testPairBWeth = "0x000000000000000000000000000000000000b001"
+	testPairCWeth = "0x000000000000000000000000000000000000c001"
+	testPairCD    = "0x000000000000000000000000000000000000c0d0"
+	testPairDWeth = "0x000000000000000000000000000000000000d001"
+)
+
+// testPair is a pair of the network factory holding `reserve0` of `token0` and `reserve1` of
+// `token1`.
+type testPair struct {
+	id                 string
+	token0, token1     string
+	reserve0, reserve1 float64
+}
+
+func TestSubgraph_findEthPriceRoute(t *testing.T) {
+	weth := MainnetProfile.NativeAddress
+
+	tests := []struct {
+		name          string
+		pairs         []testPair
+		expectedPrice float64
+		expectedPairs []string
+		expectedNil   bool
+	}{
+		{
+			name:          "direct native pair",
+			pairs:         []testPair{{testPairAWeth, testTokenA, weth, 40, 20}},
+			expectedPrice: 0.5,
+			expectedPairs: []string{testPairAWeth},
+		},
+		{
+			name:        "thin native pair",
+			pairs:       []testPair{{testPairAWeth, testTokenA, weth, 8, 4}},
+			expectedNil: true,
+		},
+		{
+			name: "deepest first hop leads to a thin second hop",
+			pairs: []testPair{
+				// 1000 B at 0.5 ETH is the deepest first hop, but B only holds 4 ETH against WETH
+				{testPairAB, testTokenA, testTokenB, 1000, 1000},
+				{testPairBWeth, testTokenB, weth, 8, 4},
+				{testPairAC, testTokenA, testTokenC, 100, 20},
+				{testPairCWeth, testTokenC, weth, 100, 100},
+			},
+			expectedPrice: 0.2,
+			expectedPairs: []string{testPairAC, testPairCWeth},
+		},
+		{
+			name: "weakest hop ranks routes",
+			pairs: []testPair{
+				// route through B holds 24 ETH on its weakest hop, the one through C 40 ETH
+				{testPairAB, testTokenA, testTokenB, 1000, 1000},
+				{testPairBWeth, testTokenB, weth, 24, 12},
+				{testPairAC, testTokenA, testTokenC, 100, 20},
+				{testPairCWeth, testTokenC, weth, 100, 100},
+			},
+			expectedPrice: 0.2,
+			expectedPairs: []string{testPairAC, testPairCWeth},
+		},
+		{
+			name: "thin first hop",
+			pairs: []testPair{
+				// 4 C at 1 ETH is under the threshold even though C is deep against WETH
+				{testPairAC, testTokenA, testTokenC, 100, 4},
+				{testPairCWeth, testTokenC, weth, 1000, 1000},
+			},
+			expectedNil: true,
+		},
+		{
+			name: "three hops",
+			pairs: []testPair{
+				{testPairAB, testTokenA, testTokenB, 100, 200},
+				{testPairBC, testTokenB, testTokenC, 100, 100},
+				{testPairCWeth, testTokenC, weth, 100, 50},
+			},
+			expectedPrice: 1,
+			expectedPairs: []string{testPairAB, testPairBC, testPairCWeth},
+		},
+		{
+			name: "route too long",
+			pairs: []testPair{
+				{testPairAB, testTokenA, testTokenB, 100, 100},
+				{testPairBC, testTokenB, testTokenC, 100, 100},
+				{testPairCD, testTokenC, testTokenD, 100, 100},
+				{testPairDWeth, testTokenD, weth, 100, 100},
+			},
+			expectedNil: true,
+		},
+		{
+			name: "no token visited twice",
+			pairs: []testPair{
+				// A to B to A to WETH would price A from its own thin native pair
+				{testPairAB, testTokenA, testTokenB, 100, 100},
+				{testPairAWeth, testTokenA, weth, 8, 4},
+			},
+			expectedNil: true,
+		},
+	}
+
+	defer func(previousPairs map[string][]string, previousTokens map[string]string) {
+		tokenPairs, tokensToPair = previousPairs, previousTokens
+	}(tokenPairs, tokensToPair)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTestSubgraph(NewTestIntrinsics(nil))
+			tokenPairs = map[string][]string{}
+			tokensToPair = map[string]string{}
+
+			for _, p := range test.pairs {
+				pair := NewPair(p.id)
+				pair.Token0, pair.Token1 = p.token0, p.token1
+				pair.Reserve0 = FL(p.reserve0)
+				pair.Reserve1 = FL(p.reserve1)
+				pair.Token0Price = FL(p.reserve0 / p.reserve1)
+				pair.Token1Price = FL(p.reserve1 / p.reserve0)
+				require.NoError(t, s.Save(pair))
+
+				tokensToPair[generateTokensKey(network.FactoryAddress, p.token0, p.token1)] = p.id
+				addTokenPair(p.token0, p.token1, p.id)
+			}
+
+			route, err := s.findEthPriceRoute(testTokenA)
+			require.NoError(t, err)
+
+			if test.expectedNil {
+				assert.Nil(t, route)
+				return
+			}
+
+			require.NotNil(t, route)
+			price, _ := route.Price.Float64()
+			assert.InDelta(t, test.expectedPrice, price, 1e-9)
+			assert.Equal(t, test.expectedPairs, route.Pairs)
+			assert.Equal(t, len(test.expectedPairs), route.Hops)
+		})
+	}
+}
+
+func testQuote(price, liquidityETH string) *EthPriceQuote {
+	p, _ := bf().SetString(price)
+	l, _ := bf().SetString(liquidityETH)
+
+	return &EthPriceQuote{Price: p, LiquidityETH: l}
+}
+
+// historicalEthPrice is the DAI/USDC/USDT weighted price as computed before reference pairs were
+// configurable per network.
+func historicalEthPrice(dai, usdc, usdt *EthPriceQuote) *big.Float {
+	totalLiquidityEth := bf().Add(dai.LiquidityETH, bf().Add(usdc.LiquidityETH, usdt.LiquidityETH).SetPrec(100)).SetPrec(100)
+
+	daiWeight := bf().Quo(dai.LiquidityETH, totalLiquidityEth).SetPrec(100)
+	usdcWeight := bf().Quo(usdc.LiquidityETH, totalLiquidityEth).SetPrec(100)
+	usdtWeight := bf().Quo(usdt.LiquidityETH, totalLiquidityEth).SetPrec(100)
+
+	weightedDaiPrice := bf().Mul(dai.Price, daiWeight).SetPrec(100)
+	weightedUsdcPrice := bf().Mul(usdc.Price, usdcWeight).SetPrec(100)
+	weightedUsdtPrice := bf().Mul(usdt.Price, usdtWeight).SetPrec(100)
+
+	return bf().Add(weightedDaiPrice, bf().Add(weightedUsdcPrice, weightedUsdtPrice)).SetPrec(100)
+}
+
+func TestWeightedAverageEthPrice(t *testing.T) {
+	tests := []struct {
+		name     string
+		quotes   []*EthPriceQuote
+		expected float64
+	}{
+		{"single quote", []*EthPriceQuote{testQuote("1800", "50")}, 1800},
+		{"same weights", []*EthPriceQuote{testQuote("1800", "50"), testQuote("1900", "50")}, 1850},
+		{"weighted", []*EthPriceQuote{testQuote("1000", "30"), testQuote("2000", "10")}, 1250},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, _ := weightedAverageEthPrice(test.quotes).Float64()
+			assert.InDelta(t, test.expected, actual, 1e-9)
+		})
+	}
+}
+
+func TestWeightedAverageEthPrice_historicalReferences(t *testing.T) {
+	tests := []struct {
+		name            string
+		dai, usdc, usdt *EthPriceQuote
+	}{
+		{
+			name: "launch",
+			dai:  testQuote("383.412587331937215693", "1532.887420337215826129"),
+			usdc: testQuote("384.061248", "2211.604810271826471934"),
+			usdt: testQuote("383.875", "947.018461982214471209"),
+		},
+		{
+			name: "uneven reserves",
+			dai:  testQuote("1854.218734910278346102", "17.284593028471002931"),
+			usdc: testQuote("1851.993811", "93811.000138472938471927"),
+			usdt: testQuote("1853.100727", "41.999999999999999999"),
+		},
+		{
+			name: "thirds",
+			dai:  testQuote("3", "1"),
+			usdc: testQuote("3", "1"),
+			usdt: testQuote("3", "1"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected := historicalEthPrice(test.dai, test.usdc, test.usdt)
+			actual := weightedAverageEthPrice([]*EthPriceQuote{test.dai, test.usdc, test.usdt})
+
+			assert.Equal(t, 0, expected.Cmp(actual), "expected %s, got %s", expected.Text('g', -1), actual.Text('g', -1))
+			assert.Equal(t, expected.Prec(), actual.Prec())
+		})
+	}
+}
+
+func TestWeightedMedianEthPrice(t *testing.T) {
+	tests := []struct {
+		name     string
+		quotes   []*EthPriceQuote
+		expected float64
+	}{
+		{"single quote", []*EthPriceQuote{testQuote("1800", "50")}, 1800},
+		{"dominant quote", []*EthPriceQuote{testQuote("1000", "10"), testQuote("2000", "80"), testQuote("3000", "10")}, 2000},
+		{"half reached on the lowest price", []*EthPriceQuote{testQuote("2000", "50"), testQuote("1000", "50")}, 1000},
+		{"outlier ignored", []*EthPriceQuote{testQuote("1800", "40"), testQuote("1810", "40"), testQuote("9000", "30")}, 1810},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, _ := weightedMedianEthPrice(test.quotes).Float64()
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestUpdateBundleEthPrice(t *testing.T) {
+	type update struct {
+		price    float64
+		block    uint64
+		expected string
+	}
+
+	tests := []struct {
+		name          string
+		updates       []update
+		expectedPrice float64
+	}{
+		{
+			name:          "first price",
+			updates:       []update{{1800, 1, ""}},
+			expectedPrice: 1800,
+		},
+		{
+			name:          "small move",
+			updates:       []update{{1800, 1, ""}, {1900, 2, ""}},
+			expectedPrice: 1900,
+		},
+		{
+			name:          "zero price",
+			updates:       []update{{1800, 1, ""}, {0, 2, priceAnomalyZero}},
+			expectedPrice: 1800,
+		},
+		{
+			name:          "spike rejected",
+			updates:       []update{{1800, 1, ""}, {9000, 2, priceAnomalyDeviation}, {1810, 3, ""}},
+			expectedPrice: 1810,
+		},
+		{
+			name:          "stale price still guarded",
+			updates:       []update{{1800, 1, ""}, {9000, 1000, priceAnomalyDeviation}},
+			expectedPrice: 1800,
+		},
+		{
+			name: "confirmed move accepted",
+			updates: []update{
+				{1800, 1, ""},
+				{9000, 10, priceAnomalyDeviation},
+				{9100, 60, priceAnomalyDeviation},
+				{9050, 110, ""},
+			},
+			expectedPrice: 9050,
+		},
+		{
+			name: "unconfirmed move restarted",
+			updates: []update{
+				{1800, 1, ""},
+				{9000, 10, priceAnomalyDeviation},
+				{30000, 60, priceAnomalyDeviation},
+				{30000, 110, priceAnomalyDeviation},
+				{30000, 160, ""},
+			},
+			expectedPrice: 30000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bundle := NewBundle("1")
+			for _, u := range test.updates {
+				assert.Equal(t, u.expected, updateBundleEthPrice(bundle, big.NewFloat(u.price), u.block), "block %d", u.block)
+			}
+
+			price, _ := bundle.EthPrice.Float().Float64()
+			assert.Equal(t, test.expectedPrice, price)
+		})
+	}
+}
+
+func TestSubgraph_savePriceAnomaly(t *testing.T) {
+	s := NewTestSubgraph(NewTestIntrinsics(nil))
+
+	syncEvent := func(logIndex int) *PairSyncEvent {
+		return &PairSyncEvent{
+			BaseEvent: &entity.BaseEvent{
+				Block:       &entity.Block{Number: 10},
+				Transaction: &entity.Transaction{Hash: eth.MustNewHash("0x" + strings.Repeat("ab", 32))},
+			},
+			LogIndex: logIndex,
+		}
+	}
+
+	require.NoError(t, s.savePriceAnomaly(syncEvent(1), NewPair(testPairAWeth), big.NewFloat(1800), big.NewFloat(9000), priceAnomalyDeviation))
+	require.NoError(t, s.savePriceAnomaly(syncEvent(2), NewPair(testPairBWeth), big.NewFloat(1800), big.NewFloat(0), priceAnomalyZero))
+
+	anomaly := NewPriceAnomaly("10")
+	require.NoError(t, s.Load(anomaly))
+	require.True(t, anomaly.Exists())
+	assert.Equal(t, testPairAWeth, anomaly.Pair)
+	assert.Equal(t, priceAnomalyDeviation, anomaly.Reason)
+}
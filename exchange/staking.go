@@ -0,0 +1,165 @@
+package exchange
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/streamingfast/eth-go"
+)
+
+// Liquidity tokens staked in MasterChef leave the wallet of their owner, they are tracked per
+// pool and counted back in the `LiquidityPosition` of the owner. The pair of a pool is learned
+// from the tokens moved by its deposits and withdrawals, pools created before the migration
+// staking tokens of another exchange until then.
+
+func (s *Subgraph) getMasterChefPool(pid *big.Int) (*MasterChefPool, error) {
+	pool := NewMasterChefPool(pid.String())
+	if err := s.Load(pool); err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// updateMasterChefStake adds `delta` to the amount staked by `user` in pool `pid`, `token` being
+// the liquidity token moved by the event at `logIndex`. Zero amount deposits and withdrawals,
+// harvesting rewards, move no token and only record the activity of the user.
+//
+// Stake amounts are summed across parallel shards at step 2, the pair of the pool being learned
+// from the moved tokens. Step 3 moves the staked balances of the positions by exact amounts, the
+// previous amount and pair of the stake being merged ones, and step 4 follows with the liquidity
+// provider counts and snapshots.
+func (s *Subgraph) updateMasterChefStake(user eth.Address, pid *big.Int, token eth.Address, logIndex int, delta *big.Float) error {
+	pool, err := s.getMasterChefPool(pid)
+	if err != nil {
+		return err
+	}
+
+	stake := NewMasterChefStake(fmt.Sprintf("%s-%s", pool.ID, user.Pretty()))
+	if err := s.Load(stake); err != nil {
+		return err
+	}
+
+	if token != nil {
+		if err := s.moveMasterChefStake(pool, stake, user, token, logIndex, delta); err != nil {
+			return err
+		}
+	}
+
+	if s.StepBelow(4) {
+		return nil
+	}
+
+	pool.LastUpdatedBlock = int64(s.Block().Number())
+	if err := s.Save(pool); err != nil {
+		return fmt.Errorf("saving pool: %w", err)
+	}
+
+	if _, err := s.recordUserActivity(user); err != nil {
+		return err
+	}
+
+	if !stake.Exists() {
+		return nil
+	}
+
+	stake.LastUpdatedBlock = int64(s.Block().Number())
+	if err := s.Save(stake); err != nil {
+		return fmt.Errorf("saving stake: %w", err)
+	}
+
+	return nil
+}
+
+// moveMasterChefStake adds `delta` to the amount of `stake`, moving it to the position of the
+// pair of `token` when the pool was migrated since the last move of the stake.
+func (s *Subgraph) moveMasterChefStake(pool *MasterChefPool, stake *MasterChefStake, user eth.Address, token eth.Address, logIndex int, delta *big.Float) error {
+	pair := NewPair(token.Pretty())
+	if err := s.Load(pair); err != nil {
+		return fmt.Errorf("loading pair %s: %w", token.Pretty(), err)
+	}
+
+	pool.Pair = nil
+	if pair.Exists() {
+		pool.Pair = &pair.ID
+	}
+
+	if err := s.Save(pool); err != nil {
+		return fmt.Errorf("saving pool: %w", err)
+	}
+
+	previousAmount := stake.Amount.Float()
+	amount := bf().Add(previousAmount, delta)
+
+	if !s.StepBelow(3) {
+		// amount already counted in the position of the current pair of the pool
+		counted := previousAmount
+		if stake.Pair != nil && (pool.Pair == nil || *stake.Pair != *pool.Pair) {
+			if err := s.addStakedBalance(user, *stake.Pair, bf().Neg(previousAmount), logIndex); err != nil {
+				return err
+			}
+			counted = bf()
+		}
+
+		if pool.Pair != nil {
+			if err := s.addStakedBalance(user, *pool.Pair, bf().Sub(amount, counted), logIndex); err != nil {
+				return err
+			}
+		}
+	}
+
+	stake.Pool = pool.ID
+	stake.User = user.Pretty()
+	stake.Amount = F(amount)
+	stake.Pair = nil
+	stake.LiquidityPosition = nil
+	if pool.Pair != nil {
+		positionID := liquidityPositionID(*pool.Pair, user.Pretty())
+		stake.Pair = pool.Pair
+		stake.LiquidityPosition = &positionID
+	}
+
+	if err := s.Save(stake); err != nil {
+		return fmt.Errorf("saving stake: %w", err)
+	}
+
+	return nil
+}
+
+// addStakedBalance adds `delta` to the staked balance of the position of `user` in the pair at
+// `pairAddress`, snapshotting it from step 4.
+func (s *Subgraph) addStakedBalance(user eth.Address, pairAddress string, delta *big.Float, logIndex int) error {
+	if delta.Sign() == 0 {
+		return nil
+	}
+
+	position, err := s.createLiquidityPosition(user, eth.MustNewAddress(pairAddress))
+	if err != nil {
+		return err
+	}
+
+	pair, err := s.getPair(eth.MustNewAddress(pairAddress))
+	if err != nil {
+		return err
+	}
+
+	totalBefore := position.TotalLiquidityTokenBalance.Float()
+	position.StakedLiquidityTokenBalance = F(bf().Add(position.StakedLiquidityTokenBalance.Float(), delta))
+	if err := s.updatePositionTotalBalance(position, pair, totalBefore); err != nil {
+		return err
+	}
+
+	if err := s.Save(position); err != nil {
+		return err
+	}
+
+	if s.StepBelow(4) {
+		return nil
+	}
+
+	if err := s.Save(pair); err != nil {
+		return err
+	}
+
+	return s.createLiquidityPositionSnapshot(position, logIndex)
+}
@@ -9,15 +9,33 @@ import (
 	"go.uber.org/zap"
 )
 
+// tokensToPair maps the factory and tokens of every pair to its address, factories being able to
+// list the same tokens
 var tokensToPair map[string]string
 
+// tokenPairs lists the pairs of every token, used to walk the pair graph when pricing tokens
+var tokenPairs map[string][]string
+
 type PairContext struct {
-	Token0 eth.Address `json:"token_0"`
-	Token1 eth.Address `json:"token_1"`
+	// Factory is empty for the pairs created before additional factories were indexed, all of
+	// them from the network factory
+	Factory eth.Address `json:"factory,omitempty"`
+	Token0  eth.Address `json:"token_0"`
+	Token1  eth.Address `json:"token_1"`
+}
+
+func (c *PairContext) factoryAddress() string {
+	if len(c.Factory) == 0 {
+		return network.FactoryAddress
+	}
+
+	return c.Factory.Pretty()
 }
 
 func (s *Subgraph) Init() error {
 	tokensToPair = make(map[string]string, len(s.DynamicDataSources))
+	tokenPairs = make(map[string][]string)
+	pairReserves = make(map[string]*pairReservesState)
 
 	for _, dds := range s.DynamicDataSources {
 		if dds.ABI != "Pair" {
@@ -28,18 +46,21 @@ func (s *Subgraph) Init() error {
 			return err
 		}
 
-		tokensToPair[generateTokensKey(ctx.Token0.Pretty(), ctx.Token1.Pretty())] = dds.GetID()
+		tokensToPair[generateTokensKey(ctx.factoryAddress(), ctx.Token0.Pretty(), ctx.Token1.Pretty())] = dds.GetID()
+		addTokenPair(ctx.Token0.Pretty(), ctx.Token1.Pretty(), dds.GetID())
 	}
 
 	return nil
 }
 
-func (s *Subgraph) CreatePairTemplateWithTokens(addr eth.Address, token0, token1 eth.Address) error {
-	tokensToPair[generateTokensKey(token0.Pretty(), token1.Pretty())] = addr.Pretty()
+func (s *Subgraph) CreatePairTemplateWithTokens(factory, addr eth.Address, token0, token1 eth.Address) error {
+	tokensToPair[generateTokensKey(factory.Pretty(), token0.Pretty(), token1.Pretty())] = addr.Pretty()
+	addTokenPair(token0.Pretty(), token1.Pretty(), addr.Pretty())
 
 	ctx := &PairContext{
-		Token0: token0,
-		Token1: token1,
+		Factory: factory,
+		Token0:  token0,
+		Token1:  token1,
 	}
 	return s.CreatePairTemplate(addr, ctx)
 }
@@ -49,17 +70,23 @@ func (s *Subgraph) LogStatus() {
 	s.Log.Debug("loaded tracked token pairs", zap.Int("count", len(tokensToPair)))
 }
 
-func (s *Subgraph) getPairAddressForTokens(token0, token1 string) string {
-	return tokensToPair[generateTokensKey(token0, token1)]
+func (s *Subgraph) getPairAddressForTokens(factory, token0, token1 string) string {
+	return tokensToPair[generateTokensKey(factory, token0, token1)]
+}
+
+func addTokenPair(token0, token1, pair string) {
+	tokenPairs[token0] = append(tokenPairs[token0], pair)
+	tokenPairs[token1] = append(tokenPairs[token1], pair)
 }
 
-func generateTokensKey(token0, token1 string) string {
+func generateTokensKey(factory, token0, token1 string) string {
+	factory = strings.ToLower(factory)
 	token0 = strings.ToLower(token0)
 	token1 = strings.ToLower(token1)
 
 	if token0 > token1 {
-		return token1 + token0
+		return factory + token1 + token0
 	}
 
-	return token0 + token1
+	return factory + token0 + token1
 }
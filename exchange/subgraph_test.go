@@ -0,0 +1,23 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateTokensKey(t *testing.T) {
+	factory := network.FactoryAddress
+	otherFactory := "0x5c69bee701ef814a2b6a3edd4b1652cb9cc5aa6f"
+
+	assert.Equal(t, generateTokensKey(factory, testTokenA, testTokenB), generateTokensKey(factory, testTokenB, testTokenA))
+	assert.NotEqual(t, generateTokensKey(factory, testTokenA, testTokenB), generateTokensKey(otherFactory, testTokenA, testTokenB))
+}
+
+func TestPairContext_factoryAddress(t *testing.T) {
+	otherFactory := eth.MustNewAddress("0x5c69bee701ef814a2b6a3edd4b1652cb9cc5aa6f")
+
+	assert.Equal(t, network.FactoryAddress, (&PairContext{}).factoryAddress())
+	assert.Equal(t, otherFactory.Pretty(), (&PairContext{Factory: otherFactory}).factoryAddress())
+}
@@ -0,0 +1,191 @@
+package exchange
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	eth "github.com/streamingfast/eth-go"
+	"github.com/streamingfast/sparkle/entity"
+	pbcodec "github.com/streamingfast/sparkle/pb/dfuse/ethereum/codec/v1"
+)
+
+// Events of the `SushiToken` data source, written after the generated ones, see
+// `datasources.go`.
+
+func decodeSushiTokenEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (interface{}, error) {
+	if IsSushiTokenTransferEvent(log) {
+		ev, err := NewSushiTokenTransferEvent(log, block, trace)
+		if err != nil {
+			return nil, fmt.Errorf("decoding SushiTokenTransfer event: %w", err)
+		}
+		return ev, nil
+	}
+
+	if IsSushiTokenDelegateChangedEvent(log) {
+		ev, err := NewSushiTokenDelegateChangedEvent(log, block, trace)
+		if err != nil {
+			return nil, fmt.Errorf("decoding SushiTokenDelegateChanged event: %w", err)
+		}
+		return ev, nil
+	}
+
+	if IsSushiTokenDelegateVotesChangedEvent(log) {
+		ev, err := NewSushiTokenDelegateVotesChangedEvent(log, block, trace)
+		if err != nil {
+			return nil, fmt.Errorf("decoding SushiTokenDelegateVotesChanged event: %w", err)
+		}
+		return ev, nil
+	}
+
+	return nil, nil
+}
+
+// SushiTokenTransfer event
+
+type SushiTokenTransferEvent struct {
+	*entity.BaseEvent
+	LogAddress eth.Address
+	LogIndex   int
+
+	// Fields
+	From  eth.Address `eth:",indexed"`
+	To    eth.Address `eth:",indexed"`
+	Value *big.Int    `eth:""`
+}
+
+var hashSushiTokenTransferEvent = eth.Keccak256([]byte("Transfer(address,address,uint256)"))
+
+func IsSushiTokenTransferEvent(log *eth.Log) bool {
+	return bytes.Equal(log.Topics[0], hashSushiTokenTransferEvent)
+}
+
+func NewSushiTokenTransferEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (*SushiTokenTransferEvent, error) {
+	var err error
+	ev := &SushiTokenTransferEvent{
+		BaseEvent:  &entity.BaseEvent{},
+		LogAddress: log.Address,
+		LogIndex:   int(log.BlockIndex),
+	}
+
+	ev.SetBlockAndTransaction(block, trace)
+
+	dec := eth.NewLogDecoder(log)
+	if _, err := dec.ReadTopic(); err != nil {
+		return nil, fmt.Errorf("reading topic 0: %w", err)
+	}
+	f0, err := dec.ReadTypedTopic("address")
+	if err != nil {
+		return nil, fmt.Errorf("reading from: %w", err)
+	}
+	ev.From = f0.(eth.Address)
+	f1, err := dec.ReadTypedTopic("address")
+	if err != nil {
+		return nil, fmt.Errorf("reading to: %w", err)
+	}
+	ev.To = f1.(eth.Address)
+	ev.Value, err = dec.DataDecoder.ReadBigInt()
+	if err != nil {
+		return nil, fmt.Errorf("reading value: %w", err)
+	}
+	return ev, nil
+}
+
+// SushiTokenDelegateChanged event
+
+type SushiTokenDelegateChangedEvent struct {
+	*entity.BaseEvent
+	LogAddress eth.Address
+	LogIndex   int
+
+	// Fields
+	Delegator    eth.Address `eth:",indexed"`
+	FromDelegate eth.Address `eth:",indexed"`
+	ToDelegate   eth.Address `eth:",indexed"`
+}
+
+var hashSushiTokenDelegateChangedEvent = eth.Keccak256([]byte("DelegateChanged(address,address,address)"))
+
+func IsSushiTokenDelegateChangedEvent(log *eth.Log) bool {
+	return bytes.Equal(log.Topics[0], hashSushiTokenDelegateChangedEvent)
+}
+
+func NewSushiTokenDelegateChangedEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (*SushiTokenDelegateChangedEvent, error) {
+	ev := &SushiTokenDelegateChangedEvent{
+		BaseEvent:  &entity.BaseEvent{},
+		LogAddress: log.Address,
+		LogIndex:   int(log.BlockIndex),
+	}
+
+	ev.SetBlockAndTransaction(block, trace)
+
+	dec := eth.NewLogDecoder(log)
+	if _, err := dec.ReadTopic(); err != nil {
+		return nil, fmt.Errorf("reading topic 0: %w", err)
+	}
+	f0, err := dec.ReadTypedTopic("address")
+	if err != nil {
+		return nil, fmt.Errorf("reading delegator: %w", err)
+	}
+	ev.Delegator = f0.(eth.Address)
+	f1, err := dec.ReadTypedTopic("address")
+	if err != nil {
+		return nil, fmt.Errorf("reading fromDelegate: %w", err)
+	}
+	ev.FromDelegate = f1.(eth.Address)
+	f2, err := dec.ReadTypedTopic("address")
+	if err != nil {
+		return nil, fmt.Errorf("reading toDelegate: %w", err)
+	}
+	ev.ToDelegate = f2.(eth.Address)
+	return ev, nil
+}
+
+// SushiTokenDelegateVotesChanged event
+
+type SushiTokenDelegateVotesChangedEvent struct {
+	*entity.BaseEvent
+	LogAddress eth.Address
+	LogIndex   int
+
+	// Fields
+	Delegate        eth.Address `eth:",indexed"`
+	PreviousBalance *big.Int    `eth:""`
+	NewBalance      *big.Int    `eth:""`
+}
+
+var hashSushiTokenDelegateVotesChangedEvent = eth.Keccak256([]byte("DelegateVotesChanged(address,uint256,uint256)"))
+
+func IsSushiTokenDelegateVotesChangedEvent(log *eth.Log) bool {
+	return bytes.Equal(log.Topics[0], hashSushiTokenDelegateVotesChangedEvent)
+}
+
+func NewSushiTokenDelegateVotesChangedEvent(log *eth.Log, block *pbcodec.Block, trace *pbcodec.TransactionTrace) (*SushiTokenDelegateVotesChangedEvent, error) {
+	var err error
+	ev := &SushiTokenDelegateVotesChangedEvent{
+		BaseEvent:  &entity.BaseEvent{},
+		LogAddress: log.Address,
+		LogIndex:   int(log.BlockIndex),
+	}
+
+	ev.SetBlockAndTransaction(block, trace)
+
+	dec := eth.NewLogDecoder(log)
+	if _, err := dec.ReadTopic(); err != nil {
+		return nil, fmt.Errorf("reading topic 0: %w", err)
+	}
+	f0, err := dec.ReadTypedTopic("address")
+	if err != nil {
+		return nil, fmt.Errorf("reading delegate: %w", err)
+	}
+	ev.Delegate = f0.(eth.Address)
+	ev.PreviousBalance, err = dec.DataDecoder.ReadBigInt()
+	if err != nil {
+		return nil, fmt.Errorf("reading previousBalance: %w", err)
+	}
+	ev.NewBalance, err = dec.DataDecoder.ReadBigInt()
+	if err != nil {
+		return nil, fmt.Errorf("reading newBalance: %w", err)
+	}
+	return ev, nil
+}
@@ -25,6 +25,12 @@ func (b blockRef) Timestamp() time.Time {
 	return b.timestamp
 }
 
+// Step completes the generated `TestIntrinsics`, which misses it to implement
+// `subgraph.Intrinsics`.
+func (i *TestIntrinsics) Step() int {
+	return i.step
+}
+
 func TestEvents(t *testing.T, s *Subgraph, events []interface{}) {
 	t.Helper()
 
@@ -2,38 +2,40 @@ package exchange
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 
 	eth "github.com/streamingfast/eth-go"
 	"github.com/streamingfast/sparkle/entity"
 )
 
-func (s *Subgraph) UpdateFactoryDayData() (*DayData, error) {
-	factory := NewFactory(FactoryAddress)
+func (s *Subgraph) UpdateFactoryDayData(factoryAddress string) (*DayData, error) {
+	factory := NewFactory(factoryAddress)
 	err := s.Load(factory)
 	if err != nil {
 		return nil, fmt.Errorf("loading factory: %w", err)
 	}
 
 	timestamp := s.Block().Timestamp().Unix()
-	dayId := timestamp / 86400
-	dayStartTimestamp := dayId * 86400
+	dayId := DayBucket.ID(timestamp)
+	dayStartTimestamp := DayBucket.Start(timestamp)
 
-	dayData := NewDayData(strconv.FormatInt(dayId, 10))
+	dayData := NewDayData(factoryDataID(factoryAddress, dayId))
 	err = s.Load(dayData)
 	if err != nil {
 		return nil, err
 	}
 
 	if !dayData.Exists() {
-		dayData = NewDayData(strconv.FormatInt(dayId, 10))
-		dayData.Factory = FactoryAddress
+		dayData = NewDayData(factoryDataID(factoryAddress, dayId))
+		dayData.Factory = factoryAddress
 		dayData.Date = dayStartTimestamp
 	}
 
+	// tx count is summed across parallel shards, so only the delta is recorded here
 	dayData.LiquidityUSD = factory.LiquidityUSD
 	dayData.LiquidityETH = factory.LiquidityETH
-	dayData.TxCount = factory.TxCount
+	dayData.TxCount = entity.IntAdd(dayData.TxCount, IL(1))
 
 	err = s.Save(dayData)
 	if err != nil {
@@ -43,116 +45,256 @@ func (s *Subgraph) UpdateFactoryDayData() (*DayData, error) {
 	return dayData, nil
 }
 
-func (s *Subgraph) UpdatePairDayData(pairAddress eth.Address) (*PairDayData, error) {
-	timestamp := s.Block().Timestamp().Unix()
-	dayId := timestamp / 86400
-	dayStartTimestamp := dayId * 86400
-	dayPairId := fmt.Sprintf("%s-%d", pairAddress.Pretty(), dayId)
-
-	pair := NewPair(pairAddress.Pretty())
-	err := s.Load(pair)
+func (s *Subgraph) UpdateFactoryHourData(factoryAddress string) (*HourData, error) {
+	factory := NewFactory(factoryAddress)
+	err := s.Load(factory)
 	if err != nil {
-		return nil, fmt.Errorf("loading pair %s: %w", pairAddress.Pretty(), err)
+		return nil, fmt.Errorf("loading factory: %w", err)
 	}
 
-	pairDayData := NewPairDayData(dayPairId)
-	err = s.Load(pairDayData)
+	timestamp := s.Block().Timestamp().Unix()
+	hourId := HourBucket.ID(timestamp)
+	hourStartUnix := HourBucket.Start(timestamp)
+
+	hourData := NewHourData(factoryDataID(factoryAddress, hourId))
+	err = s.Load(hourData)
 	if err != nil {
-		return nil, fmt.Errorf("loading pair_day_data %s: %w", dayPairId, err)
+		return nil, err
 	}
 
-	if !pairDayData.Exists() {
-		pairDayData = NewPairDayData(dayPairId)
-		pairDayData.Date = dayStartTimestamp
-		pairDayData.Token0 = pair.Token0
-		pairDayData.Token1 = pair.Token1
-		pairDayData.Pair = pairAddress.Pretty()
+	if !hourData.Exists() {
+		hourData = NewHourData(factoryDataID(factoryAddress, hourId))
+		hourData.Factory = factoryAddress
+		hourData.Date = hourStartUnix
 	}
 
-	pairDayData.TotalSupply = pair.TotalSupply
-	pairDayData.Reserve0 = pair.Reserve0
-	pairDayData.Reserve1 = pair.Reserve1
-	pairDayData.ReserveUSD = pair.ReserveUSD
-	pairDayData.TxCount = entity.IntAdd(pairDayData.TxCount, IL(1))
+	// tx count is summed across parallel shards, so only the delta is recorded here
+	hourData.LiquidityUSD = factory.LiquidityUSD
+	hourData.LiquidityETH = factory.LiquidityETH
+	hourData.TxCount = entity.IntAdd(hourData.TxCount, IL(1))
 
-	err = s.Save(pairDayData)
+	err = s.Save(hourData)
 	if err != nil {
-		return nil, fmt.Errorf("saving pair_day_data: %w", err)
+		return nil, err
 	}
 
-	return pairDayData, nil
+	return hourData, nil
 }
 
-func (s *Subgraph) UpdatePairHourData(pairAddress eth.Address) (*PairHourData, error) {
-	timestamp := s.Block().Timestamp().Unix()
-	hourId := timestamp / 3600
-	hourStartUnix := hourId * 3600
-	hourPairId := fmt.Sprintf("%s-%d", pairAddress.Pretty(), hourId)
-
+// UpdatePairBucketData updates the hour, day and interval data of `pairAddress` with its
+// current state, returning them in the order of `dataBuckets`.
+func (s *Subgraph) UpdatePairBucketData(pairAddress eth.Address) ([]pairBucketData, error) {
 	pair := NewPair(pairAddress.Pretty())
 	err := s.Load(pair)
 	if err != nil {
 		return nil, fmt.Errorf("loading pair %s: %w", pairAddress.Pretty(), err)
 	}
 
-	pairHourData := NewPairHourData(hourPairId)
-	err = s.Load(pairHourData)
-	if err != nil {
-		return nil, fmt.Errorf("loading pair_day_data %s: %w", hourPairId, err)
+	buckets := dataBuckets()
+	bucketData := make([]pairBucketData, len(buckets))
+	for i, bucket := range buckets {
+		data, err := s.getPairBucketData(pair, bucket)
+		if err != nil {
+			return nil, err
+		}
+
+		fields := data.bucketFields()
+		*fields.Reserve0 = pair.Reserve0
+		*fields.Reserve1 = pair.Reserve1
+		*fields.ReserveUSD = pair.ReserveUSD
+		*fields.Price0CumulativeLast = pair.Price0CumulativeLast
+		*fields.Price1CumulativeLast = pair.Price1CumulativeLast
+		*fields.BlockTimestampLast = pair.BlockTimestampLast
+		*fields.TxCount = entity.IntAdd(*fields.TxCount, IL(1))
+		if fields.TotalSupply != nil {
+			*fields.TotalSupply = pair.TotalSupply
+		}
+
+		if err := s.Save(data); err != nil {
+			return nil, fmt.Errorf("saving pair %s data: %w", bucket.Name, err)
+		}
+
+		bucketData[i] = data
 	}
 
-	if !pairHourData.Exists() {
-		pairHourData = NewPairHourData(hourPairId)
-		pairHourData.Date = hourStartUnix
-		pairHourData.Pair = pairAddress.Pretty()
+	return bucketData, nil
+}
+
+// UpdatePairCandles moves the price candles of the hour, day and interval data of `pair` with
+// its current prices, the USD ones being derived from the tokens ETH price.
+func (s *Subgraph) UpdatePairCandles(pair *Pair, token0, token1 *Token, ethPrice *big.Float) error {
+	token0PriceUSD := bf().Mul(token0.DerivedETH.Float(), ethPrice)
+	token1PriceUSD := bf().Mul(token1.DerivedETH.Float(), ethPrice)
+
+	for _, bucket := range dataBuckets() {
+		data, err := s.getPairBucketData(pair, bucket)
+		if err != nil {
+			return err
+		}
+
+		fields := data.bucketFields()
+		fields.Token0Price.update(pair.Token0Price.Float())
+		fields.Token1Price.update(pair.Token1Price.Float())
+		fields.Token0PriceUSD.update(token0PriceUSD)
+		fields.Token1PriceUSD.update(token1PriceUSD)
+
+		if err := s.Save(data); err != nil {
+			return fmt.Errorf("saving pair %s data: %w", bucket.Name, err)
+		}
 	}
 
-	pairHourData.Reserve0 = pair.Reserve0
-	pairHourData.Reserve1 = pair.Reserve1
-	pairHourData.ReserveUSD = pair.ReserveUSD
-	pairHourData.TxCount = entity.IntAdd(pairHourData.TxCount, IL(1))
+	return nil
+}
 
-	err = s.Save(pairHourData)
+// UpdateTokenBucketData updates the hour, day and interval data of each of `tokens`, returning
+// them per token in the order of `dataBuckets`. The total supply of a token is refreshed when its
+// day data is created, the calls of all the tokens going in a single batch.
+func (s *Subgraph) UpdateTokenBucketData(tokens ...*Token) ([][]tokenBucketData, error) {
+	bundle, err := s.getBundle()
 	if err != nil {
-		return nil, fmt.Errorf("saving pair_day_data: %w", err)
+		return nil, err
+	}
+
+	buckets := dataBuckets()
+	bucketData := make([][]tokenBucketData, len(tokens))
+	var newDayTokens []*Token
+	for i, token := range tokens {
+		bucketData[i] = make([]tokenBucketData, len(buckets))
+		for j, bucket := range buckets {
+			data, err := s.getTokenBucketData(token, bucket)
+			if err != nil {
+				return nil, err
+			}
+
+			if !data.Exists() && data.bucketFields().TotalSupply != nil {
+				newDayTokens = append(newDayTokens, token)
+			}
+
+			bucketData[i][j] = data
+		}
+	}
+
+	// refresh the supply once per day, it is only read when the token is first seen otherwise
+	if len(newDayTokens) > 0 {
+		totalSupplies, err := s.fetchTokenTotalSupplies(newDayTokens)
+		if err != nil {
+			return nil, fmt.Errorf("fetching total supplies: %w", err)
+		}
+
+		for i, token := range newDayTokens {
+			if totalSupplies[i] == nil {
+				continue
+			}
+
+			token.TotalSupply = I(totalSupplies[i])
+			if err := s.Save(token); err != nil {
+				return nil, fmt.Errorf("saving token %s: %w", token.ID, err)
+			}
+		}
+	}
+
+	for i, token := range tokens {
+		priceUSD := bf().Mul(token.DerivedETH.Float(), bundle.EthPrice.Float())
+		liquidityETH := bf().Mul(token.Liquidity.Float(), token.DerivedETH.Float())
+
+		for j, data := range bucketData[i] {
+			fields := data.bucketFields()
+			*fields.PriceUSD = F(priceUSD)
+			*fields.Liquidity = token.Liquidity
+			*fields.LiquidityETH = F(liquidityETH)
+			*fields.LiquidityUSD = F(bf().Mul(liquidityETH, bundle.EthPrice.Float()))
+			*fields.TxCount = entity.IntAdd(*fields.TxCount, IL(1))
+			if fields.TotalSupply != nil {
+				*fields.TotalSupply = token.TotalSupply
+				*fields.MarketCapUSD = F(bf().Mul(
+					entity.ConvertTokenToDecimal(token.TotalSupply.Int(), token.Decimals.Int().Int64()),
+					priceUSD,
+				))
+			}
+
+			if err := s.Save(data); err != nil {
+				return nil, fmt.Errorf("saving token %s data %s: %w", buckets[j].Name, data.GetID(), err)
+			}
+		}
 	}
 
-	return pairHourData, nil
+	return bucketData, nil
 }
 
-func (s *Subgraph) UpdateTokenDayData(token *Token) (*TokenDayData, error) {
-	bundle, err := s.getBundle()
+func (s *Subgraph) UpdateEthPriceHourData(price *big.Float, quotes []*EthPriceQuote) (*EthPriceHourData, error) {
+	timestamp := s.Block().Timestamp().Unix()
+	hourId := HourBucket.ID(timestamp)
+	hourStartUnix := HourBucket.Start(timestamp)
+
+	hourData := NewEthPriceHourData(strconv.FormatInt(hourId, 10))
+	err := s.Load(hourData)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hourData.Exists() {
+		hourData = NewEthPriceHourData(strconv.FormatInt(hourId, 10))
+		hourData.Date = hourStartUnix
+	}
+
+	updateCandle(&hourData.Open, &hourData.High, &hourData.Low, &hourData.Close, price)
+
+	err = s.Save(hourData)
 	if err != nil {
 		return nil, err
 	}
 
+	for _, quote := range quotes {
+		sourceData := s.newEthPriceSourceData(quote, fmt.Sprintf("%s-hour-%d", quote.Pair, hourId))
+		sourceData.HourData = &hourData.ID
+		if err := s.Save(sourceData); err != nil {
+			return nil, err
+		}
+	}
+
+	return hourData, nil
+}
+
+func (s *Subgraph) UpdateEthPriceDayData(price *big.Float, quotes []*EthPriceQuote) (*EthPriceDayData, error) {
 	timestamp := s.Block().Timestamp().Unix()
-	dayId := timestamp / 86400
-	dayStartTimestamp := dayId * 86400
-	tokenDayId := fmt.Sprintf("%s-%d", token.ID, dayId)
+	dayId := DayBucket.ID(timestamp)
+	dayStartTimestamp := DayBucket.Start(timestamp)
 
-	tokenDayData := NewTokenDayData(tokenDayId)
-	err = s.Load(tokenDayData)
+	dayData := NewEthPriceDayData(strconv.FormatInt(dayId, 10))
+	err := s.Load(dayData)
 	if err != nil {
-		return nil, fmt.Errorf("loading token_day_data")
+		return nil, err
 	}
 
-	if !tokenDayData.Exists() {
-		tokenDayData = NewTokenDayData(tokenDayId)
-		tokenDayData.Date = dayStartTimestamp
-		tokenDayData.Token = token.ID
+	if !dayData.Exists() {
+		dayData = NewEthPriceDayData(strconv.FormatInt(dayId, 10))
+		dayData.Date = dayStartTimestamp
 	}
 
-	tokenDayData.PriceUSD = F(bf().Mul(token.DerivedETH.Float(), bundle.EthPrice.Float()))
-	tokenDayData.Liquidity = token.Liquidity
-	tokenDayData.LiquidityETH = F(bf().Mul(token.Liquidity.Float(), token.DerivedETH.Float()))
-	tokenDayData.LiquidityUSD = F(bf().Mul(tokenDayData.LiquidityETH.Float(), bundle.EthPrice.Float()))
-	tokenDayData.TxCount = entity.IntAdd(tokenDayData.TxCount, IL(1))
+	updateCandle(&dayData.Open, &dayData.High, &dayData.Low, &dayData.Close, price)
 
-	err = s.Save(tokenDayData)
+	err = s.Save(dayData)
 	if err != nil {
-		return nil, fmt.Errorf("saving token_day_data %s: %w", tokenDayData.ID, err)
+		return nil, err
+	}
+
+	for _, quote := range quotes {
+		sourceData := s.newEthPriceSourceData(quote, fmt.Sprintf("%s-day-%d", quote.Pair, dayId))
+		sourceData.DayData = &dayData.ID
+		if err := s.Save(sourceData); err != nil {
+			return nil, err
+		}
 	}
 
-	return tokenDayData, nil
+	return dayData, nil
+}
+
+func (s *Subgraph) newEthPriceSourceData(quote *EthPriceQuote, id string) *EthPriceSourceData {
+	sourceData := NewEthPriceSourceData(id)
+	sourceData.Pair = quote.Pair
+	sourceData.Price = F(quote.Price)
+	sourceData.LiquidityETH = F(quote.LiquidityETH)
+	sourceData.Used = entity.NewBool(quote.Used)
+
+	return sourceData
 }
@@ -0,0 +1,30 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubgraph_UpdateFactoryData_txCount(t *testing.T) {
+	s := NewTestSubgraph(NewTestIntrinsics(nil))
+
+	factory := NewFactory(network.FactoryAddress)
+	factory.TxCount = IL(10)
+	require.NoError(t, s.Save(factory))
+
+	var dayData *DayData
+	var hourData *HourData
+	for i := 0; i < 2; i++ {
+		var err error
+		dayData, err = s.UpdateFactoryDayData(factory.ID)
+		require.NoError(t, err)
+
+		hourData, err = s.UpdateFactoryHourData(factory.ID)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int64(2), dayData.TxCount.Int().Int64())
+	assert.Equal(t, int64(2), hourData.TxCount.Int().Int64())
+}
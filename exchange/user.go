@@ -1,12 +1,16 @@
 package exchange
 
 import (
+	"math/big"
+
 	"github.com/streamingfast/eth-go"
 	"github.com/streamingfast/sparkle/entity"
 )
 
+// createUser saves the user at `address`, users are shared by every factory and counted on the
+// network one.
 func (s *Subgraph) createUser(address eth.Address) (*User, error) {
-	factory, err := s.getFactory()
+	factory, err := s.getFactory(network.FactoryAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +21,8 @@ func (s *Subgraph) createUser(address eth.Address) (*User, error) {
 	}
 
 	user := NewUser(address.Pretty())
+	user.FirstSeenBlock = int64(s.Block().Number())
+	user.LastActiveBlock = int64(s.Block().Number())
 	if err := s.Save(user); err != nil {
 		return nil, err
 	}
@@ -37,3 +43,36 @@ func (s *Subgraph) getUser(address eth.Address) (*User, error) {
 
 	return user, nil
 }
+
+// recordUserActivity loads the user at `address`, creating it if needed, and marks it active
+// at the current block.
+func (s *Subgraph) recordUserActivity(address eth.Address) (*User, error) {
+	user, err := s.getUser(address)
+	if err != nil {
+		return nil, err
+	}
+
+	user.LastActiveBlock = int64(s.Block().Number())
+	if err := s.Save(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// updateUserActivePositions applies the change of a position balance to the active position
+// count of its user, only the delta is recorded since the count is summed across parallel shards.
+func (s *Subgraph) updateUserActivePositions(address eth.Address, balanceBefore, balanceAfter *big.Float) error {
+	delta := liquidityProviderCountDelta(balanceBefore, balanceAfter)
+	if delta == 0 {
+		return nil
+	}
+
+	user, err := s.getUser(address)
+	if err != nil {
+		return err
+	}
+
+	user.ActivePositionCount = entity.IntAdd(user.ActivePositionCount, IL(delta))
+	return s.Save(user)
+}
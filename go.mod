@@ -3,6 +3,7 @@ module github.com/streamingfast/sushi-generated-priv
 go 1.15
 
 require (
+	github.com/spf13/cobra v1.1.3
 	github.com/streamingfast/eth-go v0.0.0-20210831180555-8d52c827993b
 	github.com/streamingfast/logging v0.0.0-20210811175431-f3b44b61606a
 	github.com/streamingfast/sparkle v0.0.0-20210910163029-8dfb95f44634